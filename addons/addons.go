@@ -18,10 +18,14 @@ import (
 	_ "github.com/invopop/gobl/addons/es/tbai"
 	_ "github.com/invopop/gobl/addons/es/verifactu"
 	_ "github.com/invopop/gobl/addons/eu/en16931"
+	_ "github.com/invopop/gobl/addons/eu/peppol"
 	_ "github.com/invopop/gobl/addons/fr/choruspro"
 	_ "github.com/invopop/gobl/addons/fr/facturx"
 	_ "github.com/invopop/gobl/addons/gr/mydata"
+	_ "github.com/invopop/gobl/addons/hr/fiskalizacija"
 	_ "github.com/invopop/gobl/addons/it/sdi"
 	_ "github.com/invopop/gobl/addons/it/ticket"
+	_ "github.com/invopop/gobl/addons/my/myinvois"
 	_ "github.com/invopop/gobl/addons/pl/favat"
+	_ "github.com/invopop/gobl/addons/ro/efactura"
 )
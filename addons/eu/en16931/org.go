@@ -7,8 +7,10 @@ import (
 	"github.com/invopop/gobl/catalogues/untdid"
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/au"
 	"github.com/invopop/gobl/regimes/dk"
 	"github.com/invopop/gobl/regimes/fr"
+	"github.com/invopop/gobl/regimes/nz"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/rules/is"
 	"github.com/invopop/gobl/tax"
@@ -58,6 +60,8 @@ var orgIdentityTypeSchemeMap = map[cbc.Code]cbc.Code{
 	fr.IdentityTypeSIREN: "0002", // French SIREN (legal identifier)
 	fr.IdentityTypeSIRET: "0009", // French SIRET (private identifier)
 	dk.IdentityTypeCVR:   "0184", // Danish CVR-nummer
+	au.IdentityTypeABN:   "0151", // Australian Business Number (A-NZ Peppol)
+	nz.IdentityTypeNZBN:  "0088", // New Zealand Business Number, a GLN (A-NZ Peppol)
 }
 
 var (
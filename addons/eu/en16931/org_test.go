@@ -125,6 +125,20 @@ func TestOrgIdentityNormalize(t *testing.T) {
 		assert.Equal(t, "1234567890123", id.Code.String())
 		assert.Equal(t, "0002", id.Ext.Get(iso.ExtKeySchemeID).String())
 	})
+	t.Run("normalizes A-NZ types", func(t *testing.T) {
+		id := &org.Identity{
+			Type: "ABN",
+			Code: "51824753556",
+		}
+		norm.Normalize(id, tax.AddonContext(en16931.V2017))
+		assert.Equal(t, "0151", id.Ext.Get(iso.ExtKeySchemeID).String())
+		id = &org.Identity{
+			Type: "NZBN",
+			Code: "9429041535134",
+		}
+		norm.Normalize(id, tax.AddonContext(en16931.V2017))
+		assert.Equal(t, "0088", id.Ext.Get(iso.ExtKeySchemeID).String())
+	})
 }
 
 func TestOrgInboxNormalize(t *testing.T) {
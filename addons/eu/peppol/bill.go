@@ -0,0 +1,279 @@
+package peppol
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/catalogues/iso"
+	"github.com/invopop/gobl/catalogues/untdid"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pay"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// PEPPOL-EN16931-R008 (no empty elements) and the syntax binding rules
+// are the responsibility of converters. NO-R-001 (Norwegian VAT numbers
+// with the MVA suffix) is covered by the NO regime's tax identity rules.
+
+var (
+	// DK-R-005: payment means codes accepted from Danish suppliers.
+	dkPaymentMeans = []cbc.Code{"1", "10", "31", "42", "48", "49", "50", "58", "59", "93", "97"}
+
+	// NL-R-008: payment means codes accepted from Dutch suppliers.
+	nlPaymentMeans = []cbc.Code{"30", "48", "49", "57", "58", "59"}
+
+	// SE-R-012: VAT rates permitted for Swedish suppliers with standard rated
+	// items.
+	sePercents = []num.Percentage{
+		num.MakePercentage(250, 3),
+		num.MakePercentage(120, 3),
+		num.MakePercentage(60, 3),
+	}
+)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.Assert("01", "buyer reference (ordering code) or purchase order reference is required (PEPPOL-EN16931-R003)",
+			is.Func("has buyer reference", invoiceHasBuyerReference),
+		),
+		rules.Assert("02", "no more than one note is allowed, unless supplier and customer are in Germany (PEPPOL-EN16931-R002)",
+			is.Func("one note", invoiceHasOneNote),
+		),
+		rules.Field("supplier",
+			rules.Assert("03", "supplier electronic address is required as a peppol inbox or endpoint (PEPPOL-EN16931-R020)",
+				is.Func("has participant", partyHasParticipant),
+			),
+		),
+		rules.Field("customer",
+			rules.Assert("04", "customer electronic address is required as a peppol inbox or endpoint (PEPPOL-EN16931-R010)",
+				is.Func("has participant", partyHasParticipant),
+			),
+		),
+		rules.Assert("05", "line periods must be within the invoice period (PEPPOL-EN16931-R110, PEPPOL-EN16931-R111)",
+			is.Func("line periods", invoiceLinePeriodsWithinPeriod),
+		),
+		rules.Field("payment",
+			rules.Field("instructions",
+				rules.When(
+					is.Func("direct debit", instructionsHaveDirectDebit),
+					rules.Field("direct_debit",
+						rules.Assert("06", "direct debit details are required for direct debit payments (PEPPOL-EN16931-R061)", is.Present),
+						rules.Field("ref",
+							rules.Assert("07", "direct debit mandate reference is required (PEPPOL-EN16931-R061)", is.Present),
+						),
+					),
+				),
+			),
+		),
+		// Sweden
+		rules.When(
+			is.Func("swedish supplier", supplierIn(l10n.SE)),
+			rules.Field("lines",
+				rules.Each(
+					rules.Field("taxes",
+						rules.Assert("08", "standard VAT rate for Swedish suppliers must be 25%, 12%, or 6% (SE-R-012)",
+							is.Func("valid rate", swedishStandardRates),
+						),
+					),
+				),
+			),
+		),
+		// Denmark
+		rules.When(
+			is.Func("danish supplier", supplierIn(l10n.DK)),
+			rules.Field("supplier",
+				rules.Assert("09", "Danish supplier requires a tax ID code or CVR identity (DK-R-002)",
+					is.Func("has CVR", partyHasTaxIDOrScheme(SchemeDK)),
+				),
+			),
+			rules.Field("payment",
+				rules.Field("instructions",
+					rules.Field("ext",
+						rules.AssertIfPresent("10", "payment means code not allowed for Danish suppliers (DK-R-005)",
+							tax.ExtensionsHasCodes(untdid.ExtKeyPaymentMeans, dkPaymentMeans...),
+						),
+					),
+				),
+			),
+		),
+		// Netherlands
+		rules.When(
+			is.Func("dutch supplier", supplierIn(l10n.NL)),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote),
+				rules.Field("preceding",
+					rules.Assert("11", "credit notes from Dutch suppliers must reference the invoice (NL-R-001)", is.Present),
+				),
+			),
+			rules.Field("supplier",
+				rules.Assert("12", "Dutch supplier address requires a street, locality, and code (NL-R-002)",
+					is.Func("complete address", partyHasCompleteAddress),
+				),
+				rules.Assert("13", "Dutch supplier requires a KVK or OIN identity (NL-R-003)",
+					is.Func("has KVK or OIN", partyHasScheme(SchemeKVK, SchemeOIN)),
+				),
+			),
+			rules.When(
+				is.Func("dutch customer", customerIn(l10n.NL)),
+				rules.Field("customer",
+					rules.Assert("14", "Dutch customer address requires a street, locality, and code (NL-R-004)",
+						is.Func("complete address", partyHasCompleteAddress),
+					),
+					rules.Assert("15", "Dutch customer requires a KVK or OIN identity (NL-R-005)",
+						is.Func("has KVK or OIN", partyHasScheme(SchemeKVK, SchemeOIN)),
+					),
+				),
+			),
+			rules.Field("payment",
+				rules.Field("instructions",
+					rules.Field("ext",
+						rules.AssertIfPresent("16", "payment means code not allowed for Dutch suppliers (NL-R-008)",
+							tax.ExtensionsHasCodes(untdid.ExtKeyPaymentMeans, nlPaymentMeans...),
+						),
+					),
+				),
+			),
+		),
+	)
+}
+
+func invoiceHasBuyerReference(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	if !ok || inv == nil {
+		return true
+	}
+	o := inv.Ordering
+	if o == nil {
+		return false
+	}
+	if o.Code != cbc.CodeEmpty {
+		return true
+	}
+	for _, p := range o.Purchases {
+		if p != nil && p.Code != cbc.CodeEmpty {
+			return true
+		}
+	}
+	return false
+}
+
+func invoiceHasOneNote(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	if !ok || inv == nil || len(inv.Notes) <= 1 {
+		return true
+	}
+	return partyCountry(inv.Supplier) == l10n.DE.Tax() && partyCountry(inv.Customer) == l10n.DE.Tax()
+}
+
+func invoiceLinePeriodsWithinPeriod(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	if !ok || inv == nil || inv.Ordering == nil || inv.Ordering.Period == nil {
+		return true
+	}
+	p := inv.Ordering.Period
+	for _, l := range inv.Lines {
+		if l == nil || l.Period == nil {
+			continue
+		}
+		if l.Period.Start.Before(p.Start.Date) || l.Period.End.After(p.End.Date) {
+			return false
+		}
+	}
+	return true
+}
+
+func instructionsHaveDirectDebit(val any) bool {
+	instr, ok := val.(*pay.Instructions)
+	return ok && instr != nil && instr.Key.Has("direct-debit")
+}
+
+func swedishStandardRates(val any) bool {
+	ts, ok := val.(tax.Set)
+	if !ok {
+		return true
+	}
+	c := ts.Get(tax.CategoryVAT)
+	if c == nil || c.Percent == nil || (c.Key != cbc.KeyEmpty && c.Key != tax.KeyStandard) {
+		return true
+	}
+	for _, p := range sePercents {
+		if c.Percent.Equals(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func supplierIn(country l10n.Code) func(any) bool {
+	return func(val any) bool {
+		inv, ok := val.(*bill.Invoice)
+		return ok && inv != nil && partyCountry(inv.Supplier) == country.Tax()
+	}
+}
+
+func customerIn(country l10n.Code) func(any) bool {
+	return func(val any) bool {
+		inv, ok := val.(*bill.Invoice)
+		return ok && inv != nil && partyCountry(inv.Customer) == country.Tax()
+	}
+}
+
+func partyHasParticipant(val any) bool {
+	p, ok := val.(*org.Party)
+	if !ok || p == nil {
+		return true // handled elsewhere
+	}
+	if p.Endpoint(ParticipantScheme) != nil {
+		return true
+	}
+	for _, in := range p.Inboxes {
+		if in != nil && in.Key == org.InboxKeyPeppol && in.Code != cbc.CodeEmpty {
+			return true
+		}
+	}
+	return false
+}
+
+func partyHasCompleteAddress(val any) bool {
+	p, ok := val.(*org.Party)
+	if !ok || p == nil {
+		return true
+	}
+	if len(p.Addresses) == 0 || p.Addresses[0] == nil {
+		return false
+	}
+	a := p.Addresses[0]
+	return a.Street != "" && a.Locality != "" && a.Code != cbc.CodeEmpty
+}
+
+func partyHasScheme(schemes ...cbc.Code) func(any) bool {
+	return func(val any) bool {
+		p, ok := val.(*org.Party)
+		if !ok || p == nil {
+			return true
+		}
+		for _, id := range p.Identities {
+			if id != nil && id.Ext.Get(iso.ExtKeySchemeID).In(schemes...) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func partyHasTaxIDOrScheme(schemes ...cbc.Code) func(any) bool {
+	hasScheme := partyHasScheme(schemes...)
+	return func(val any) bool {
+		p, ok := val.(*org.Party)
+		if !ok || p == nil {
+			return true
+		}
+		if p.TaxID != nil && p.TaxID.Code != cbc.CodeEmpty {
+			return true
+		}
+		return hasScheme(val)
+	}
+}
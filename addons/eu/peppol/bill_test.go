@@ -0,0 +1,284 @@
+package peppol_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/eu/peppol"
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/catalogues/iso"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pay"
+	_ "github.com/invopop/gobl/regimes"
+	"github.com/invopop/gobl/regimes/dk"
+	"github.com/invopop/gobl/regimes/nl"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInvoiceStandard(t *testing.T) *bill.Invoice {
+	t.Helper()
+	return &bill.Invoice{
+		Regime:    tax.WithRegime("BE"),
+		Addons:    tax.WithAddons(peppol.V3),
+		IssueDate: cal.MakeDate(2025, 6, 2),
+		Series:    "INV",
+		Code:      "0001",
+		Ordering: &bill.Ordering{
+			Code: "PO-4567",
+		},
+		Supplier: &org.Party{
+			Name: "Leverancier NV",
+			TaxID: &tax.Identity{
+				Country: "BE",
+				Code:    "0403170701",
+			},
+			Inboxes: []*org.Inbox{
+				{Code: "0208:0403170701"},
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Rue de la Loi",
+					Number:   "16",
+					Locality: "Bruxelles",
+					Code:     "1000",
+					Country:  "BE",
+				},
+			},
+		},
+		Customer: &org.Party{
+			Name: "Klant BV",
+			TaxID: &tax.Identity{
+				Country: "BE",
+				Code:    "0202239951",
+			},
+			Inboxes: []*org.Inbox{
+				{Scheme: "0208", Code: "0202239951"},
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Meir",
+					Number:   "1",
+					Locality: "Antwerpen",
+					Code:     "2000",
+					Country:  "BE",
+				},
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Consulting",
+					Price: num.NewAmount(10000, 2),
+					Unit:  org.UnitHour,
+				},
+				Taxes: tax.Set{
+					{
+						Category: "VAT",
+						Rate:     "general",
+					},
+				},
+			},
+		},
+		Payment: &bill.PaymentDetails{
+			Terms: &pay.Terms{
+				Notes: "30 days",
+			},
+		},
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("valid invoice", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		require.Len(t, inv.Supplier.Endpoints, 1)
+		assert.Equal(t, cbc.URI("iso6523-actorid-upis::0208:0403170701"), inv.Supplier.Endpoints[0].URI)
+	})
+
+	t.Run("missing buyer reference", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Ordering = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-01]")
+	})
+
+	t.Run("purchase order reference", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Ordering = &bill.Ordering{
+			Purchases: []*org.DocumentRef{{Code: "PO-1"}},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+
+	t.Run("multiple notes", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Notes = []*org.Note{{Text: "One"}, {Text: "Two"}}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-02]")
+	})
+
+	t.Run("missing supplier electronic address", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.Inboxes = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-03]")
+	})
+
+	t.Run("customer endpoint only", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Inboxes = nil
+		inv.Customer.Endpoints = []*org.Endpoint{
+			{URI: "iso6523-actorid-upis::0208:0202239951"},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+
+	t.Run("missing customer electronic address", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Inboxes = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-04]")
+	})
+
+	t.Run("line period outside invoice period", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Ordering.Period = &cal.Period{
+			Start: cal.MakeDate(2025, 5, 1),
+			End:   cal.MakeDate(2025, 5, 31),
+		}
+		inv.Lines[0].Period = &cal.Period{
+			Start: cal.MakeDate(2025, 5, 15),
+			End:   cal.MakeDate(2025, 6, 15),
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-05]")
+	})
+
+	t.Run("direct debit without mandate", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Payment.Instructions = &pay.Instructions{
+			Key:         pay.MeansKeyDirectDebit,
+			DirectDebit: &pay.DirectDebit{Creditor: "BE68ZZZ0123456789"},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-07]")
+	})
+}
+
+func TestInvoiceSwedishRules(t *testing.T) {
+	inv := testInvoiceStandard(t)
+	inv.Regime = tax.WithRegime("SE")
+	inv.Supplier.TaxID = &tax.Identity{Country: "SE", Code: "202100548901"}
+	inv.Supplier.Inboxes = []*org.Inbox{{Code: "0007:5560360793"}}
+	inv.Supplier.Addresses[0].Country = "SE"
+	inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Percent: num.NewPercentage(250, 3)}
+	require.NoError(t, inv.Calculate())
+	require.NoError(t, rules.Validate(inv))
+
+	inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Percent: num.NewPercentage(200, 3)}
+	require.NoError(t, inv.Calculate())
+	assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-08]")
+}
+
+func TestInvoiceDanishRules(t *testing.T) {
+	danish := func(t *testing.T) *bill.Invoice {
+		inv := testInvoiceStandard(t)
+		inv.Regime = tax.WithRegime("DK")
+		inv.Supplier.TaxID = &tax.Identity{Country: "DK"}
+		inv.Supplier.Identities = []*org.Identity{
+			{Type: dk.IdentityTypeCVR, Code: "13585628"},
+		}
+		inv.Supplier.Inboxes = []*org.Inbox{{Code: "0184:13585628"}}
+		inv.Supplier.Addresses[0].Country = "DK"
+		return inv
+	}
+	t.Run("valid", func(t *testing.T) {
+		inv := danish(t)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, peppol.SchemeDK, inv.Supplier.Identities[0].Ext.Get(iso.ExtKeySchemeID))
+		require.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing CVR", func(t *testing.T) {
+		inv := danish(t)
+		inv.Supplier.Identities = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-09]")
+	})
+	t.Run("payment means not allowed", func(t *testing.T) {
+		inv := danish(t)
+		inv.Payment.Instructions = &pay.Instructions{
+			Key: pay.MeansKeyCheque,
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-10]")
+	})
+}
+
+func TestInvoiceDutchRules(t *testing.T) {
+	dutch := func(t *testing.T) *bill.Invoice {
+		inv := testInvoiceStandard(t)
+		inv.Regime = tax.WithRegime("NL")
+		inv.Supplier.TaxID = &tax.Identity{Country: "NL", Code: "000099998B57"}
+		inv.Supplier.Identities = []*org.Identity{
+			{Type: nl.IdentityTypeKVK, Code: "12345678"},
+		}
+		inv.Supplier.Inboxes = []*org.Inbox{{Code: "0106:12345678"}}
+		inv.Supplier.Addresses[0] = &org.Address{
+			Street:   "Damrak",
+			Number:   "1",
+			Locality: "Amsterdam",
+			Code:     "1012 LG",
+			Country:  "NL",
+		}
+		return inv
+	}
+	t.Run("valid", func(t *testing.T) {
+		inv := dutch(t)
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+	})
+	t.Run("credit note without preceding", func(t *testing.T) {
+		inv := dutch(t)
+		inv.Type = bill.InvoiceTypeCreditNote
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-11]")
+	})
+	t.Run("incomplete supplier address", func(t *testing.T) {
+		inv := dutch(t)
+		inv.Supplier.Addresses[0].Code = ""
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-12]")
+	})
+	t.Run("missing supplier KVK", func(t *testing.T) {
+		inv := dutch(t)
+		inv.Supplier.Identities = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-13]")
+	})
+	t.Run("dutch customer", func(t *testing.T) {
+		inv := dutch(t)
+		inv.Customer.TaxID = &tax.Identity{Country: "NL", Code: "808661863B01"}
+		inv.Customer.Addresses[0] = &org.Address{Locality: "Utrecht", Country: "NL"}
+		require.NoError(t, inv.Calculate())
+		err := rules.Validate(inv)
+		assert.ErrorContains(t, err, "[GOBL-EU-PEPPOL-BILL-INVOICE-14]")
+		assert.ErrorContains(t, err, "[GOBL-EU-PEPPOL-BILL-INVOICE-15]")
+	})
+	t.Run("payment means not allowed", func(t *testing.T) {
+		inv := dutch(t)
+		inv.Payment.Instructions = &pay.Instructions{
+			Key: pay.MeansKeyCash,
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EU-PEPPOL-BILL-INVOICE-16]")
+	})
+}
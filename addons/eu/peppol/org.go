@@ -0,0 +1,276 @@
+package peppol
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/catalogues/iso"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pkg/luhn"
+	"github.com/invopop/gobl/regimes/dk"
+	"github.com/invopop/gobl/regimes/nl"
+	"github.com/invopop/gobl/regimes/no"
+	"github.com/invopop/gobl/regimes/se"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// ParticipantScheme is the URI scheme used for Peppol participant
+// identifier endpoints.
+const ParticipantScheme = "iso6523-actorid-upis"
+
+// ISO 6523 ICD scheme codes with specific validation rules.
+const (
+	SchemeSE  cbc.Code = "0007" // Swedish organisation number
+	SchemeGLN cbc.Code = "0088" // GS1 Global Location Number
+	SchemeKVK cbc.Code = "0106" // Dutch Chamber of Commerce number
+	SchemeDK  cbc.Code = "0184" // Danish CVR number
+	SchemeOIN cbc.Code = "0190" // Dutch government organisation ID
+	SchemeNO  cbc.Code = "0192" // Norwegian organisation number
+	SchemeBE  cbc.Code = "0208" // Belgian enterprise number
+)
+
+// easCodes contains the active Electronic Address Scheme codes that may
+// be used in Peppol participant IDs (PEPPOL-EN16931-CL008).
+var easCodes = []cbc.Code{
+	"0002", "0007", "0009", "0037", "0060", "0088", "0096", "0097",
+	"0106", "0130", "0135", "0142", "0147", "0151", "0154", "0158",
+	"0170", "0183", "0184", "0188", "0190", "0191", "0192", "0193",
+	"0194", "0195", "0196", "0198", "0199", "0200", "0201", "0202",
+	"0203", "0204", "0205", "0208", "0209", "0210", "0211", "0212",
+	"0213", "0215", "0216", "0217", "0218", "0219", "0220", "0221",
+	"0225", "0230", "0235", "0240", "0244",
+	"9901", "9910", "9913", "9914", "9915", "9918", "9919", "9920",
+	"9922", "9923", "9924", "9925", "9926", "9927", "9928", "9929",
+	"9930", "9931", "9932", "9933", "9934", "9935", "9936", "9937",
+	"9938", "9939", "9940", "9941", "9942", "9943", "9944", "9945",
+	"9946", "9947", "9948", "9949", "9950", "9951", "9952", "9953",
+	"9957", "9959",
+	"AN", "AQ", "AS", "AU", "EM",
+}
+
+// identityTypeSchemes maps regime identity types to their ICD scheme for
+// each country, as type codes are only unique within a regime.
+var identityTypeSchemes = map[l10n.TaxCountryCode]map[cbc.Code]cbc.Code{
+	"NO": {no.IdentityTypeOrgNr: SchemeNO},
+	"SE": {se.IdentityTypeOrgNr: SchemeSE},
+	"DK": {dk.IdentityTypeCVR: SchemeDK},
+	"NL": {nl.IdentityTypeKVK: SchemeKVK, nl.IdentityTypeOIN: SchemeOIN},
+}
+
+var (
+	// Inbox code normalization may have collapsed the double colon.
+	participantPrefixRegexp = regexp.MustCompile(`(?i)^` + ParticipantScheme + `::?`)
+	participantRegexp       = regexp.MustCompile(`^([0-9]{4}|[A-Z]{2}):(.+)$`)
+	numericSchemeRegexp     = regexp.MustCompile(`[^0-9]`)
+)
+
+// schemeValidators check the participant or legal identity code using the
+// rules defined for each ICD scheme.
+var schemeValidators = map[cbc.Code]func(string) bool{
+	SchemeGLN: validGLN,               // PEPPOL-COMMON-R040
+	SchemeNO:  validNorwegianOrgNr,    // PEPPOL-COMMON-R041
+	SchemeDK:  validDanishCVR,         // PEPPOL-COMMON-R042
+	SchemeBE:  validBelgianEnterprise, // PEPPOL-COMMON-R043
+	SchemeSE:  validSwedishOrgNr,      // SE-R-005
+}
+
+// normalizeOrgParty ensures inboxes with an EAS code are treated as Peppol
+// participant IDs, adds the matching endpoint, and assigns ICD schemes to
+// national legal identities.
+func normalizeOrgParty(p *org.Party) {
+	if p == nil {
+		return
+	}
+	for _, in := range p.Inboxes {
+		normalizeParticipantInbox(in)
+	}
+	if p.Endpoint(ParticipantScheme) == nil {
+		for _, in := range p.Inboxes {
+			if in == nil || in.Key != org.InboxKeyPeppol || in.Scheme == cbc.CodeEmpty || in.Code == cbc.CodeEmpty {
+				continue
+			}
+			p.Endpoints = append(p.Endpoints, &org.Endpoint{
+				Label: in.Label,
+				URI:   cbc.URI(ParticipantScheme + "::" + in.Scheme.String() + ":" + in.Code.String()),
+			})
+			break
+		}
+	}
+	if schemes, ok := identityTypeSchemes[partyCountry(p)]; ok {
+		for _, id := range p.Identities {
+			if id == nil || id.Ext.Has(iso.ExtKeySchemeID) {
+				continue
+			}
+			if scheme, ok := schemes[id.Type]; ok {
+				id.Ext = id.Ext.Merge(tax.ExtensionsOf(cbc.CodeMap{
+					iso.ExtKeySchemeID: scheme,
+				}))
+			}
+		}
+	}
+}
+
+func normalizeParticipantInbox(in *org.Inbox) {
+	if in == nil || in.Code == cbc.CodeEmpty {
+		return
+	}
+	code := participantPrefixRegexp.ReplaceAllString(in.Code.String(), "")
+	if in.Scheme == cbc.CodeEmpty {
+		if m := participantRegexp.FindStringSubmatch(code); m != nil {
+			in.Scheme = cbc.Code(m[1])
+			code = m[2]
+		}
+	}
+	in.Code = cbc.Code(code)
+	if !in.Scheme.In(easCodes...) {
+		return
+	}
+	if in.Key == cbc.KeyEmpty {
+		in.Key = org.InboxKeyPeppol
+	}
+	if _, ok := schemeValidators[in.Scheme]; ok {
+		// numeric schemes
+		in.Code = cbc.Code(numericSchemeRegexp.ReplaceAllString(code, ""))
+	}
+}
+
+// partyCountry determines the country of the party from the tax ID or
+// the first address.
+func partyCountry(p *org.Party) l10n.TaxCountryCode {
+	if p == nil {
+		return ""
+	}
+	if p.TaxID != nil && p.TaxID.Country != "" {
+		return p.TaxID.Country
+	}
+	if len(p.Addresses) > 0 && p.Addresses[0] != nil {
+		return l10n.TaxCountryCode(p.Addresses[0].Country)
+	}
+	return ""
+}
+
+func orgInboxRules() *rules.Set {
+	return rules.For(new(org.Inbox),
+		rules.When(
+			is.Func("peppol inbox", isPeppolInbox),
+			rules.Field("scheme",
+				rules.Assert("01", "peppol inbox scheme is required", is.Present),
+				rules.AssertIfPresent("02", "peppol inbox scheme must be a valid EAS code (PEPPOL-EN16931-CL008)",
+					cbc.InCodes(easCodes...),
+				),
+			),
+			rules.Assert("03", "peppol inbox code is not valid for the scheme (PEPPOL-COMMON-R040 to R043)",
+				is.Func("valid participant code", isValidInboxCode),
+			),
+		),
+	)
+}
+
+func orgIdentityRules() *rules.Set {
+	return rules.For(new(org.Identity),
+		rules.Assert("01", "identity code is not valid for the ISO 6523 scheme (PEPPOL-COMMON-R040 to R043)",
+			is.Func("valid scheme code", isValidIdentityCode),
+		),
+	)
+}
+
+func isPeppolInbox(val any) bool {
+	in, ok := val.(*org.Inbox)
+	return ok && in != nil && in.Key == org.InboxKeyPeppol
+}
+
+func isValidInboxCode(val any) bool {
+	in, ok := val.(*org.Inbox)
+	if !ok || in == nil || in.Code == cbc.CodeEmpty {
+		return true
+	}
+	return validSchemeCode(in.Scheme, in.Code.String())
+}
+
+func isValidIdentityCode(val any) bool {
+	id, ok := val.(*org.Identity)
+	if !ok || id == nil || id.Code == cbc.CodeEmpty {
+		return true
+	}
+	return validSchemeCode(id.Ext.Get(iso.ExtKeySchemeID), id.Code.String())
+}
+
+func validSchemeCode(scheme cbc.Code, code string) bool {
+	fn, ok := schemeValidators[scheme]
+	if !ok {
+		return true
+	}
+	return fn(numericSchemeRegexp.ReplaceAllString(code, ""))
+}
+
+// validGLN checks the GS1 modulus 10 check digit.
+func validGLN(code string) bool {
+	if len(code) != 13 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d := int(code[11-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(code[12]-'0')
+}
+
+// validNorwegianOrgNr checks the modulus 11 check digit of 9 digit
+// organisation numbers.
+func validNorwegianOrgNr(code string) bool {
+	return validMod11(code, []int{3, 2, 7, 6, 5, 4, 3, 2})
+}
+
+// validDanishCVR checks the modulus 11 check of 8 digit CVR numbers.
+func validDanishCVR(code string) bool {
+	if len(code) != 8 {
+		return false
+	}
+	sum := 0
+	for i, w := range []int{2, 7, 6, 5, 4, 3, 2, 1} {
+		sum += int(code[i]-'0') * w
+	}
+	return sum%11 == 0
+}
+
+// validBelgianEnterprise checks the modulus 97 check of 10 digit
+// enterprise numbers.
+func validBelgianEnterprise(code string) bool {
+	if len(code) != 10 || (code[0] != '0' && code[0] != '1') {
+		return false
+	}
+	n := 0
+	for _, c := range code[:8] {
+		n = n*10 + int(c-'0')
+	}
+	check := int(code[8]-'0')*10 + int(code[9]-'0')
+	return 97-n%97 == check
+}
+
+// validSwedishOrgNr checks the Luhn check digit of 10 digit
+// organisation numbers.
+func validSwedishOrgNr(code string) bool {
+	return len(code) == 10 && luhn.Check(cbc.Code(code))
+}
+
+func validMod11(code string, weights []int) bool {
+	if len(code) != len(weights)+1 {
+		return false
+	}
+	sum := 0
+	for i, w := range weights {
+		sum += int(code[i]-'0') * w
+	}
+	check := 11 - sum%11
+	if check == 11 {
+		check = 0
+	}
+	return check != 10 && check == int(code[len(weights)]-'0')
+}
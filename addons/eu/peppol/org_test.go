@@ -0,0 +1,131 @@
+package peppol_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/eu/peppol"
+	"github.com/invopop/gobl/catalogues/iso"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/no"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartyNormalization(t *testing.T) {
+	t.Run("participant ID in code", func(t *testing.T) {
+		p := &org.Party{
+			Name: "Test",
+			Inboxes: []*org.Inbox{
+				{Code: "iso6523-actorid-upis::0208:0403.170.701"},
+			},
+		}
+		norm.Normalize(p, tax.AddonContext(peppol.V3))
+		in := p.Inboxes[0]
+		assert.Equal(t, org.InboxKeyPeppol, in.Key)
+		assert.Equal(t, cbc.Code("0208"), in.Scheme)
+		assert.Equal(t, cbc.Code("0403170701"), in.Code)
+		require.Len(t, p.Endpoints, 1)
+		assert.Equal(t, cbc.URI("iso6523-actorid-upis::0208:0403170701"), p.Endpoints[0].URI)
+	})
+
+	t.Run("VAT based scheme", func(t *testing.T) {
+		p := &org.Party{
+			Name: "Test",
+			Inboxes: []*org.Inbox{
+				{Scheme: "9925", Code: "BE0403170701"},
+			},
+		}
+		norm.Normalize(p, tax.AddonContext(peppol.V3))
+		assert.Equal(t, org.InboxKeyPeppol, p.Inboxes[0].Key)
+		assert.Equal(t, cbc.Code("BE0403170701"), p.Inboxes[0].Code)
+	})
+
+	t.Run("existing endpoint", func(t *testing.T) {
+		p := &org.Party{
+			Name: "Test",
+			Inboxes: []*org.Inbox{
+				{Scheme: "0088", Code: "9429041535134"},
+			},
+			Endpoints: []*org.Endpoint{
+				{URI: "iso6523-actorid-upis::0088:9429041535134"},
+			},
+		}
+		norm.Normalize(p, tax.AddonContext(peppol.V3))
+		assert.Len(t, p.Endpoints, 1)
+	})
+
+	t.Run("unknown scheme", func(t *testing.T) {
+		p := &org.Party{
+			Name: "Test",
+			Inboxes: []*org.Inbox{
+				{Scheme: "1234", Code: "ABC"},
+			},
+		}
+		norm.Normalize(p, tax.AddonContext(peppol.V3))
+		assert.Empty(t, p.Inboxes[0].Key)
+		assert.Empty(t, p.Endpoints)
+	})
+
+	t.Run("national identity scheme", func(t *testing.T) {
+		p := &org.Party{
+			Name: "Test",
+			TaxID: &tax.Identity{
+				Country: "NO",
+				Code:    "923456783MVA",
+			},
+			Identities: []*org.Identity{
+				{Type: no.IdentityTypeOrgNr, Code: "923456783"},
+			},
+		}
+		norm.Normalize(p, tax.AddonContext(peppol.V3))
+		assert.Equal(t, peppol.SchemeNO, p.Identities[0].Ext.Get(iso.ExtKeySchemeID))
+	})
+}
+
+func TestInboxRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme cbc.Code
+		code   cbc.Code
+		err    string
+	}{
+		{name: "GLN", scheme: "0088", code: "9429041535134"},
+		{name: "NO", scheme: "0192", code: "923456783"},
+		{name: "DK", scheme: "0184", code: "13585628"},
+		{name: "BE", scheme: "0208", code: "0403170701"},
+		{name: "SE", scheme: "0007", code: "5560360793"},
+		{name: "VAT", scheme: "9944", code: "NL000099998B57"},
+		{name: "bad GLN", scheme: "0088", code: "9429041535135", err: "EU-PEPPOL-ORG-INBOX-03"},
+		{name: "bad NO", scheme: "0192", code: "987654321", err: "EU-PEPPOL-ORG-INBOX-03"},
+		{name: "bad DK", scheme: "0184", code: "12345678", err: "EU-PEPPOL-ORG-INBOX-03"},
+		{name: "bad BE", scheme: "0208", code: "0403170702", err: "EU-PEPPOL-ORG-INBOX-03"},
+		{name: "bad SE", scheme: "0007", code: "5560360794", err: "EU-PEPPOL-ORG-INBOX-03"},
+		{name: "unknown scheme", scheme: "1234", code: "ABC", err: "EU-PEPPOL-ORG-INBOX-02"},
+		{name: "missing scheme", code: "ABC", err: "EU-PEPPOL-ORG-INBOX-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := &org.Inbox{Key: org.InboxKeyPeppol, Scheme: tt.scheme, Code: tt.code}
+			err := rules.Validate(in, tax.AddonContext(peppol.V3))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestIdentityRules(t *testing.T) {
+	id := &org.Identity{
+		Code: "5560360794",
+		Ext:  tax.ExtensionsOf(cbc.CodeMap{iso.ExtKeySchemeID: peppol.SchemeSE}),
+	}
+	assert.ErrorContains(t, rules.Validate(id, tax.AddonContext(peppol.V3)), "[GOBL-EU-PEPPOL-ORG-IDENTITY-01]")
+	id.Code = "5560360793"
+	assert.NoError(t, rules.Validate(id, tax.AddonContext(peppol.V3)))
+}
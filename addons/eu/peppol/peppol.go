@@ -0,0 +1,92 @@
+// Package peppol provides the Peppol BIS Billing 3.0 addon which extends the EN 16931
+// rules with those defined by OpenPeppol for documents exchanged over the network.
+package peppol
+
+import (
+	"github.com/invopop/gobl/addons/eu/en16931"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// Key identifies the Peppol addon family. Individual versions append a
+	// suffix; the family key is used as the fault-code namespace so that
+	// rules that carry across versions keep stable codes.
+	Key cbc.Key = "eu-peppol"
+
+	// V3 is the key for Peppol BIS Billing 3.0.
+	V3 cbc.Key = Key + "-v3"
+)
+
+// Identifiers mandated by Peppol BIS Billing 3.0 which must be used by
+// converters in the document's customization (BT-24) and profile (BT-23)
+// IDs.
+const (
+	CustomizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	ProfileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+)
+
+func init() {
+	tax.RegisterAddonDef(newAddon())
+	rules.RegisterWithGuard(
+		Key.String(),
+		rules.GOBL.Add("EU-PEPPOL"),
+		is.InContext(tax.AddonIn(V3)),
+		billInvoiceRules(),
+		orgInboxRules(),
+		orgIdentityRules(),
+	)
+	norm.RegisterWithGuard(
+		is.InContext(tax.AddonIn(V3)),
+		norm.For(normalizeOrgParty),
+	)
+}
+
+func newAddon() *tax.AddonDef {
+	return &tax.AddonDef{
+		Key: V3,
+		Name: i18n.String{
+			i18n.EN: "Peppol BIS Billing 3.0",
+		},
+		Requires: []cbc.Key{
+			en16931.V2017,
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Support for the Peppol BIS Billing 3.0 specification, which extends EN 16931
+				with the rules required to exchange invoices and credit notes over the Peppol
+				network.
+
+				Converters must use the following identifiers:
+
+				| Element | Value |
+				|---------|-------|
+				| Customization ID (BT-24) | ` + "`" + CustomizationID + "`" + ` |
+				| Profile ID (BT-23) | ` + "`" + ProfileID + "`" + ` |
+
+				Party inboxes with an EAS (Electronic Address Scheme) code will be normalized
+				into Peppol participant IDs, and the corresponding ` + "`iso6523-actorid-upis`" + `
+				endpoints added. Participant IDs and legal identities with known ISO 6523 ICD
+				schemes will have their check digits validated.
+
+				Alongside the PEPPOL-EN16931 rules, the national rules for suppliers in Norway,
+				Sweden, Denmark, and the Netherlands are applied.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Peppol BIS Billing 3.0"),
+				URL:   "https://docs.peppol.eu/poacc/billing/3.0/",
+			},
+			{
+				Title: i18n.NewString("Peppol Electronic Address Scheme Code List"),
+				URL:   "https://docs.peppol.eu/poacc/billing/3.0/codelist/eas/",
+			},
+		},
+	}
+}
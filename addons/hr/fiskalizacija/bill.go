@@ -0,0 +1,85 @@
+package fiskalizacija
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func normalizeBillInvoice(inv *bill.Invoice) {
+	if inv == nil {
+		return
+	}
+	if inv.Tax != nil && inv.Tax.Ext.Has(ExtKeyProcess) {
+		return
+	}
+	inv.Tax = inv.Tax.MergeExtensions(tax.ExtensionsOf(cbc.CodeMap{
+		ExtKeyProcess: ProcessContract,
+	}))
+}
+
+// Invoice rules cover the HR-CIUS requirements for fiscalized B2B invoices,
+// where both parties are identified by their OIB and the supplier declares
+// the operator who issued the document.
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.Field("tax",
+			rules.Assert("01", "tax is required", is.Present),
+			rules.Field("ext",
+				rules.Assert("02",
+					fmt.Sprintf("tax requires '%s' extension", ExtKeyProcess),
+					tax.ExtensionsRequire(ExtKeyProcess),
+				),
+				rules.Assert("03",
+					fmt.Sprintf("tax '%s' extension must be a valid business process", ExtKeyProcess),
+					tax.ExtensionHasValidCode(ExtKeyProcess),
+				),
+			),
+		),
+		rules.Field("supplier",
+			rules.Field("tax_id",
+				rules.Assert("04", "supplier tax ID is required", is.Present),
+				rules.Field("country",
+					rules.Assert("05", "supplier tax ID must be Croatian", is.In(l10n.TaxCountryCode(hr.CountryCode))),
+				),
+				rules.Field("code",
+					rules.Assert("06", "supplier tax ID code is required", is.Present),
+				),
+			),
+			rules.Field("people",
+				rules.Assert("07", "supplier must include the invoice operator",
+					is.Func("has operator", peopleHasOperator),
+				),
+			),
+		),
+		rules.Field("customer",
+			rules.Assert("08", "customer is required", is.Present),
+			rules.Field("tax_id",
+				rules.Assert("09", "customer tax ID is required", is.Present),
+				rules.Field("code",
+					rules.Assert("10", "customer tax ID code is required", is.Present),
+				),
+			),
+		),
+	)
+}
+
+func peopleHasOperator(val any) bool {
+	people, ok := val.([]*org.Person)
+	if !ok {
+		return false
+	}
+	for _, p := range people {
+		if p != nil && p.Key == PersonKeyOperator {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package fiskalizacija_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/hr/fiskalizacija"
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pay"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInvoiceStandard(t *testing.T) *bill.Invoice {
+	t.Helper()
+	return &bill.Invoice{
+		Regime:    tax.WithRegime("HR"),
+		Addons:    tax.WithAddons(fiskalizacija.V2),
+		IssueDate: cal.MakeDate(2026, 1, 15),
+		Code:      "1-POSL1-1",
+		Supplier: &org.Party{
+			Name: "Dobavljač d.o.o.",
+			TaxID: &tax.Identity{
+				Country: "HR",
+				Code:    "69435151530",
+			},
+			People: []*org.Person{
+				{
+					Key: fiskalizacija.PersonKeyOperator,
+					Name: &org.Name{
+						Given:   "Ivan",
+						Surname: "Horvat",
+					},
+					Identities: []*org.Identity{
+						{
+							Type: hr.IdentityTypeOIB,
+							Code: "33813276504",
+						},
+					},
+				},
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Ilica",
+					Number:   "1",
+					Locality: "Zagreb",
+					Code:     "10000",
+					Country:  "HR",
+				},
+			},
+		},
+		Customer: &org.Party{
+			Name: "Kupac d.d.",
+			TaxID: &tax.Identity{
+				Country: "HR",
+				Code:    "94577403194",
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Riva",
+					Number:   "8",
+					Locality: "Split",
+					Code:     "21000",
+					Country:  "HR",
+				},
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Usluge razvoja softvera",
+					Price: num.NewAmount(5000, 2),
+					Unit:  org.UnitHour,
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						fiskalizacija.ExtKeyKPD: "62.10.11",
+					}),
+				},
+				Taxes: tax.Set{
+					{
+						Category: "VAT",
+						Rate:     "general",
+					},
+				},
+			},
+		},
+		Payment: &bill.PaymentDetails{
+			Terms: &pay.Terms{
+				Notes: "Plaćanje u roku od 30 dana",
+			},
+		},
+	}
+}
+
+func TestInvoiceNormalization(t *testing.T) {
+	t.Run("default process", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, fiskalizacija.ProcessContract, inv.Tax.Ext.Get(fiskalizacija.ExtKeyProcess))
+	})
+	t.Run("keeps process", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Tax = &bill.Tax{
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				fiskalizacija.ExtKeyProcess: "P2",
+			}),
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, cbc.Code("P2"), inv.Tax.Ext.Get(fiskalizacija.ExtKeyProcess))
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("valid invoice", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("invalid process", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		inv.Tax.Ext = inv.Tax.Ext.Set(fiskalizacija.ExtKeyProcess, "P13")
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-03]")
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-04]")
+	})
+	t.Run("foreign supplier", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.TaxID = &tax.Identity{Country: "DE", Code: "111111125"}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-05]")
+	})
+	t.Run("missing operator", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.People[0].Key = ""
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-07]")
+	})
+	t.Run("missing customer", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-08]")
+	})
+	t.Run("missing customer tax ID", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-FISKALIZACIJA-BILL-INVOICE-09]")
+	})
+}
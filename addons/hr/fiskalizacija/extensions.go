@@ -0,0 +1,151 @@
+package fiskalizacija
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Extension keys used by the addon.
+const (
+	ExtKeyKPD     cbc.Key = "hr-fiskalizacija-kpd"
+	ExtKeyProcess cbc.Key = "hr-fiskalizacija-process"
+)
+
+// Business process codes, only the most common are exposed as constants.
+const (
+	ProcessContract   cbc.Code = "P1"
+	ProcessCreditNote cbc.Code = "P9"
+	ProcessCustom     cbc.Code = "P99"
+)
+
+// kpdPattern matches KPD 2025 codes at the sub-category level, such as
+// "62.10.11".
+const kpdPattern = `^\d{2}\.\d{2}\.\d{2}$`
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyKPD,
+		Name: i18n.String{
+			i18n.EN: "KPD Product Classification",
+			i18n.HR: "Klasifikacija proizvoda po djelatnostima (KPD)",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Code from the KPD 2025 classification of products by activity, required for
+				every line item and mapped to the item classification identifier with the
+				~CG~ scheme in HR-CIUS. Codes must be provided to at least the six digit
+				sub-category level using the dotted format, for example ~62.10.11~.
+				Codes provided without dots will be formatted automatically.
+			`),
+		},
+		Pattern: kpdPattern,
+	},
+	{
+		Key: ExtKeyProcess,
+		Name: i18n.String{
+			i18n.EN: "Business Process",
+			i18n.HR: "Poslovni proces",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Business process in which the invoice was issued, mapped to the profile
+				identifier in HR-CIUS. GOBL will set ~P1~ automatically when no value is
+				provided.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: ProcessContract,
+				Name: i18n.String{
+					i18n.EN: "Invoicing of deliveries of goods and services against purchase orders, based on a contract",
+					i18n.HR: "Izdavanje računa za isporuke robe i usluga prema narudžbenicama, na temelju ugovora",
+				},
+			},
+			{
+				Code: "P2",
+				Name: i18n.String{
+					i18n.EN: "Periodic invoicing of deliveries based on a contract",
+					i18n.HR: "Periodično izdavanje računa za isporuke na temelju ugovora",
+				},
+			},
+			{
+				Code: "P3",
+				Name: i18n.String{
+					i18n.EN: "Invoicing the delivery over an unforeseen order",
+					i18n.HR: "Izdavanje računa za isporuku po nepredviđenoj narudžbenici",
+				},
+			},
+			{
+				Code: "P4",
+				Name: i18n.String{
+					i18n.EN: "Advance payment",
+					i18n.HR: "Plaćanje predujma",
+				},
+			},
+			{
+				Code: "P5",
+				Name: i18n.String{
+					i18n.EN: "Spot payment",
+					i18n.HR: "Plaćanje na licu mjesta",
+				},
+			},
+			{
+				Code: "P6",
+				Name: i18n.String{
+					i18n.EN: "Payment before delivery, based on a purchase order",
+					i18n.HR: "Plaćanje prije isporuke, na temelju narudžbenice",
+				},
+			},
+			{
+				Code: "P7",
+				Name: i18n.String{
+					i18n.EN: "Invoices with references to a dispatch advice",
+					i18n.HR: "Računi s referencama na otpremnicu",
+				},
+			},
+			{
+				Code: "P8",
+				Name: i18n.String{
+					i18n.EN: "Invoices with references to dispatch and receipt advices",
+					i18n.HR: "Računi s referencama na otpremnicu i primku",
+				},
+			},
+			{
+				Code: ProcessCreditNote,
+				Name: i18n.String{
+					i18n.EN: "Credit notes or invoices with negative amounts",
+					i18n.HR: "Odobrenja ili računi s negativnim iznosima",
+				},
+			},
+			{
+				Code: "P10",
+				Name: i18n.String{
+					i18n.EN: "Corrective invoicing",
+					i18n.HR: "Izdavanje korektivnog računa",
+				},
+			},
+			{
+				Code: "P11",
+				Name: i18n.String{
+					i18n.EN: "Partial and final invoicing",
+					i18n.HR: "Izdavanje djelomičnog i završnog računa",
+				},
+			},
+			{
+				Code: "P12",
+				Name: i18n.String{
+					i18n.EN: "Self-billing",
+					i18n.HR: "Samoizdavanje računa",
+				},
+			},
+			{
+				Code: ProcessCustom,
+				Name: i18n.String{
+					i18n.EN: "Process defined by the customer",
+					i18n.HR: "Proces koji definira kupac",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,87 @@
+// Package fiskalizacija provides extensions and validations for the Croatian
+// HR-CIUS profile of EN 16931 used by the Fiscalization 2.0 e-invoicing system.
+package fiskalizacija
+
+import (
+	"github.com/invopop/gobl/addons/eu/en16931"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// Key identifies the Fiscalization addon family. Individual versions
+	// append a suffix; the family key is used as the fault-code namespace so
+	// that rules that carry across versions keep stable codes.
+	Key cbc.Key = "hr-fiskalizacija"
+
+	// V2 is the key for Fiscalization 2.0 and the HR-CIUS 2025 specification.
+	V2 cbc.Key = Key + "-v2"
+)
+
+func init() {
+	tax.RegisterAddonDef(newAddon())
+	rules.RegisterWithGuard(
+		Key.String(),
+		rules.GOBL.Add("HR-FISKALIZACIJA"),
+		is.InContext(tax.AddonIn(V2)),
+		billInvoiceRules(),
+		orgItemRules(),
+		orgPersonRules(),
+	)
+	norm.RegisterWithGuard(
+		is.InContext(tax.AddonIn(V2)),
+		norm.For(normalizeBillInvoice),
+		norm.For(normalizeOrgItem),
+	)
+}
+
+func newAddon() *tax.AddonDef {
+	return &tax.AddonDef{
+		Key: V2,
+		Name: i18n.String{
+			i18n.EN: "Croatia Fiscalization 2.0 (HR-CIUS)",
+			i18n.HR: "Hrvatska Fiskalizacija 2.0 (HR-CIUS)",
+		},
+		Requires: []cbc.Key{
+			en16931.V2017,
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Support for the Croatian HR-CIUS specification of EN 16931, mandatory from
+				1 January 2026 for domestic B2B invoices exchanged and fiscalized under the
+				Fiscalization 2.0 (Fiskalizacija 2.0) act.
+
+				Every line item must be classified using the KPD 2025 product classification
+				in the ~hr-fiskalizacija-kpd~ extension, and the invoice must define the
+				business process in the ~hr-fiskalizacija-process~ tax extension, which
+				defaults to ~P1~.
+
+				Both parties must be identified by their OIB, and the supplier must include
+				the operator who issued the invoice as a person with the ~operator~ key
+				and an ~OIB~ identity.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "Tax Administration - Fiscalization 2.0",
+					i18n.HR: "Porezna uprava - Fiskalizacija 2.0",
+				},
+				URL: "https://porezna-uprava.gov.hr/fiskalizacija/8000",
+			},
+			{
+				Title: i18n.String{
+					i18n.EN: "HR-CIUS specification for e-invoices",
+					i18n.HR: "Specifikacija osnovne uporabe eRačuna s proširenjima (HR-CIUS)",
+				},
+				URL: "https://porezna.gov.hr/fiskalizacija/api/dokumenti/101",
+			},
+		},
+		Extensions: extensions,
+	}
+}
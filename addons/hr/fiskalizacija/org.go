@@ -0,0 +1,65 @@
+package fiskalizacija
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// PersonKeyOperator identifies the supplier's person who issued the
+// invoice, known as the operator in HR-CIUS.
+const PersonKeyOperator cbc.Key = "operator"
+
+// kpdDigitsRegexp matches KPD codes provided without the dot separators.
+var kpdDigitsRegexp = regexp.MustCompile(`^(\d{2})(\d{2})(\d{2})$`)
+
+func normalizeOrgItem(item *org.Item) {
+	if item == nil {
+		return
+	}
+	code := item.Ext.Get(ExtKeyKPD)
+	if code == cbc.CodeEmpty {
+		return
+	}
+	if m := kpdDigitsRegexp.FindStringSubmatch(code.String()); m != nil {
+		item.Ext = item.Ext.Set(ExtKeyKPD, cbc.Code(m[1]+"."+m[2]+"."+m[3]))
+	}
+}
+
+func orgItemRules() *rules.Set {
+	return rules.For(new(org.Item),
+		rules.Field("ext",
+			rules.Assert("01",
+				fmt.Sprintf("item requires '%s' extension", ExtKeyKPD),
+				tax.ExtensionsRequire(ExtKeyKPD),
+			),
+			rules.Assert("02",
+				fmt.Sprintf("item '%s' extension must be a valid KPD code", ExtKeyKPD),
+				tax.ExtensionHasValidCode(ExtKeyKPD),
+			),
+		),
+	)
+}
+
+func orgPersonRules() *rules.Set {
+	return rules.For(new(org.Person),
+		rules.When(is.Func("operator", isOperator),
+			rules.Field("identities",
+				rules.Assert("01", "operator requires an OIB identity",
+					org.IdentitiesTypeIn(hr.IdentityTypeOIB),
+				),
+			),
+		),
+	)
+}
+
+func isOperator(val any) bool {
+	p, ok := val.(*org.Person)
+	return ok && p != nil && p.Key == PersonKeyOperator
+}
@@ -0,0 +1,98 @@
+package fiskalizacija_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/hr/fiskalizacija"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgItemNormalization(t *testing.T) {
+	tests := []struct {
+		name string
+		code cbc.Code
+		want cbc.Code
+	}{
+		{name: "dotted", code: "62.10.11", want: "62.10.11"},
+		{name: "digits", code: "621011", want: "62.10.11"},
+		{name: "other", code: "6210", want: "6210"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &org.Item{
+				Name: "Test",
+				Ext:  tax.ExtensionsOf(cbc.CodeMap{fiskalizacija.ExtKeyKPD: tt.code}),
+			}
+			norm.Normalize(item, tax.AddonContext(fiskalizacija.V2))
+			assert.Equal(t, tt.want, item.Ext.Get(fiskalizacija.ExtKeyKPD))
+		})
+	}
+}
+
+func TestOrgItemValidation(t *testing.T) {
+	ctx := tax.AddonContext(fiskalizacija.V2)
+	t.Run("valid", func(t *testing.T) {
+		item := &org.Item{
+			Name: "Test",
+			Ext:  tax.ExtensionsOf(cbc.CodeMap{fiskalizacija.ExtKeyKPD: "62.10.11"}),
+		}
+		assert.NoError(t, rules.Validate(item, ctx))
+	})
+	t.Run("missing KPD", func(t *testing.T) {
+		item := &org.Item{Name: "Test"}
+		assert.ErrorContains(t, rules.Validate(item, ctx), "[GOBL-HR-FISKALIZACIJA-ORG-ITEM-01]")
+	})
+	t.Run("bad KPD format", func(t *testing.T) {
+		item := &org.Item{
+			Name: "Test",
+			Ext:  tax.ExtensionsOf(cbc.CodeMap{fiskalizacija.ExtKeyKPD: "62.10"}),
+		}
+		assert.ErrorContains(t, rules.Validate(item, ctx), "[GOBL-HR-FISKALIZACIJA-ORG-ITEM-02]")
+	})
+}
+
+func TestOrgPersonValidation(t *testing.T) {
+	ctx := []rules.WithContext{
+		tax.RegimeContext(hr.CountryCode),
+		tax.AddonContext(fiskalizacija.V2),
+	}
+	t.Run("valid operator", func(t *testing.T) {
+		p := &org.Person{
+			Key:  fiskalizacija.PersonKeyOperator,
+			Name: &org.Name{Given: "Ivan", Surname: "Horvat"},
+			Identities: []*org.Identity{
+				{Type: hr.IdentityTypeOIB, Code: "33813276504"},
+			},
+		}
+		assert.NoError(t, rules.Validate(p, ctx...))
+	})
+	t.Run("operator without OIB", func(t *testing.T) {
+		p := &org.Person{
+			Key:  fiskalizacija.PersonKeyOperator,
+			Name: &org.Name{Given: "Ivan", Surname: "Horvat"},
+		}
+		assert.ErrorContains(t, rules.Validate(p, ctx...), "[GOBL-HR-FISKALIZACIJA-ORG-PERSON-01]")
+	})
+	t.Run("operator with invalid OIB", func(t *testing.T) {
+		p := &org.Person{
+			Key:  fiskalizacija.PersonKeyOperator,
+			Name: &org.Name{Given: "Ivan", Surname: "Horvat"},
+			Identities: []*org.Identity{
+				{Type: hr.IdentityTypeOIB, Code: "33813276505"},
+			},
+		}
+		assert.ErrorContains(t, rules.Validate(p, ctx...), "[GOBL-HR-ORG-IDENTITY-01]")
+	})
+	t.Run("other person", func(t *testing.T) {
+		p := &org.Person{
+			Name: &org.Name{Given: "Ana", Surname: "Kovač"},
+		}
+		assert.NoError(t, rules.Validate(p, ctx...))
+	})
+}
@@ -0,0 +1,103 @@
+package myinvois
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// partyIdentityTypes are the identity schemes accepted by MyInvois to
+// complement a party's TIN.
+var partyIdentityTypes = []cbc.Code{
+	my.IdentityTypeBRN,
+	my.IdentityTypeNRIC,
+	my.IdentityTypePassport,
+	my.IdentityTypeArmy,
+}
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.Assert("01", "invoice must be in MYR or provide exchange rate for conversion", currency.CanConvertTo(currency.MYR)),
+		rules.Field("tax",
+			rules.Assert("02", "tax is required", is.Present),
+			rules.Field("ext",
+				rules.Assert("03",
+					fmt.Sprintf("tax requires '%s' extension", ExtKeyDocType),
+					tax.ExtensionsRequire(ExtKeyDocType),
+				),
+				rules.Assert("04",
+					fmt.Sprintf("tax '%s' extension must be a valid e-invoice type", ExtKeyDocType),
+					tax.ExtensionHasValidCode(ExtKeyDocType),
+				),
+			),
+		),
+		rules.Field("supplier",
+			rules.Field("tax_id",
+				rules.Assert("05", "supplier tax ID is required", is.Present),
+				rules.Field("code",
+					rules.Assert("06", "supplier tax ID code is required", is.Present),
+				),
+			),
+			rules.Field("identities",
+				rules.Assert("07", "supplier requires a BRN, NRIC, PASSPORT or ARMY identity",
+					org.IdentitiesTypeIn(partyIdentityTypes...),
+				),
+			),
+			rules.Field("ext",
+				rules.Assert("08",
+					fmt.Sprintf("supplier requires '%s' extension", my.ExtKeyMSIC),
+					tax.ExtensionsRequire(my.ExtKeyMSIC),
+				),
+			),
+			rules.Field("addresses",
+				rules.Assert("09", "supplier addresses are required", is.Present),
+			),
+			rules.Field("telephones",
+				rules.Assert("10", "supplier telephones are required", is.Present),
+			),
+		),
+		rules.Field("customer",
+			rules.Assert("11", "customer is required", is.Present),
+			rules.Field("tax_id",
+				rules.Assert("12", "customer tax ID is required", is.Present),
+				rules.Field("code",
+					rules.Assert("13", "customer tax ID code is required", is.Present),
+				),
+			),
+		),
+		// Consolidated e-invoices are issued to the general public, so the
+		// customer details beyond the general TIN are not available.
+		rules.When(is.Func("not consolidated", isNotConsolidatedInvoice),
+			rules.Field("customer",
+				rules.Field("identities",
+					rules.Assert("14", "customer requires a BRN, NRIC, PASSPORT or ARMY identity",
+						org.IdentitiesTypeIn(partyIdentityTypes...),
+					),
+				),
+				rules.Field("addresses",
+					rules.Assert("15", "customer addresses are required", is.Present),
+				),
+				rules.Field("telephones",
+					rules.Assert("16", "customer telephones are required", is.Present),
+				),
+			),
+		),
+		rules.When(bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote, bill.InvoiceTypeDebitNote),
+			rules.Field("preceding",
+				rules.Assert("17", "preceding documents are required for credit and debit notes", is.Present),
+			),
+		),
+	)
+}
+
+func isNotConsolidatedInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && !inv.HasTags(my.TagConsolidated)
+}
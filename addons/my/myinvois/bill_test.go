@@ -0,0 +1,201 @@
+package myinvois_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/my/myinvois"
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInvoiceStandard(t *testing.T) *bill.Invoice {
+	t.Helper()
+	return &bill.Invoice{
+		Regime:    tax.WithRegime("MY"),
+		Addons:    tax.WithAddons(myinvois.V1),
+		IssueDate: cal.MakeDate(2025, 6, 1),
+		Code:      "INV-2025-001",
+		Supplier: &org.Party{
+			Name: "Pembekal Sdn. Bhd.",
+			TaxID: &tax.Identity{
+				Country: "MY",
+				Code:    "C2584563200",
+			},
+			Identities: []*org.Identity{
+				{
+					Type: my.IdentityTypeBRN,
+					Code: "202001234567",
+				},
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Jalan Sultan Ismail",
+					Number:   "10",
+					Locality: "Kuala Lumpur",
+					State:    "MY-14",
+					Code:     "50250",
+					Country:  "MY",
+				},
+			},
+			Telephones: []*org.Telephone{
+				{Number: "+60321234567"},
+			},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				my.ExtKeyMSIC: "62010",
+			}),
+		},
+		Customer: &org.Party{
+			Name: "Pelanggan Bhd.",
+			TaxID: &tax.Identity{
+				Country: "MY",
+				Code:    "C4890799050",
+			},
+			Identities: []*org.Identity{
+				{
+					Type: my.IdentityTypeBRN,
+					Code: "201901000005",
+				},
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Jalan Tun Razak",
+					Locality: "Shah Alam",
+					State:    "10",
+					Code:     "40000",
+					Country:  "MY",
+				},
+			},
+			Telephones: []*org.Telephone{
+				{Number: "+60355551234"},
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Perkhidmatan perundingan IT",
+					Price: num.NewAmount(1000, 0),
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						my.ExtKeyClassification: "022",
+					}),
+				},
+				Taxes: tax.Set{
+					{Category: my.TaxCategorySVT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceScenarios(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  cbc.Key
+		tags []cbc.Key
+		code cbc.Code
+	}{
+		{name: "invoice", typ: bill.InvoiceTypeStandard, code: "01"},
+		{name: "credit note", typ: bill.InvoiceTypeCreditNote, code: "02"},
+		{name: "debit note", typ: bill.InvoiceTypeDebitNote, code: "03"},
+		{name: "self-billed invoice", typ: bill.InvoiceTypeStandard, tags: []cbc.Key{tax.TagSelfBilled}, code: "11"},
+		{name: "self-billed credit note", typ: bill.InvoiceTypeCreditNote, tags: []cbc.Key{tax.TagSelfBilled}, code: "12"},
+		{name: "self-billed debit note", typ: bill.InvoiceTypeDebitNote, tags: []cbc.Key{tax.TagSelfBilled}, code: "13"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := testInvoiceStandard(t)
+			inv.Type = tt.typ
+			inv.SetTags(tt.tags...)
+			require.NoError(t, inv.Calculate())
+			assert.Equal(t, tt.code, inv.Tax.Ext.Get(myinvois.ExtKeyDocType))
+		})
+	}
+}
+
+func TestInvoiceRefundNoteScenarios(t *testing.T) {
+	t.Run("refund note", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Tax = &bill.Tax{Ext: tax.ExtensionsOf(cbc.CodeMap{myinvois.ExtKeyDocType: "04"})}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+		assert.Equal(t, cbc.Code("04"), inv.Tax.Ext.Get(myinvois.ExtKeyDocType))
+	})
+	t.Run("self-billed refund note", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.SetTags(tax.TagSelfBilled)
+		inv.Tax = &bill.Tax{Ext: tax.ExtensionsOf(cbc.CodeMap{myinvois.ExtKeyDocType: "04"})}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, cbc.Code("14"), inv.Tax.Ext.Get(myinvois.ExtKeyDocType))
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("valid invoice", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+		assert.Equal(t, cbc.Code("14"), inv.Supplier.Addresses[0].State)
+	})
+	t.Run("foreign currency without rate", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Currency = currency.USD
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-01]")
+	})
+	t.Run("missing supplier identity", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.Identities = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-07]")
+	})
+	t.Run("missing supplier MSIC", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.Ext = tax.Extensions{}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-08]")
+	})
+	t.Run("missing supplier telephone", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.Telephones = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-10]")
+	})
+	t.Run("missing customer", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-11]")
+	})
+	t.Run("missing customer address", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Addresses = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-15]")
+	})
+	t.Run("credit note without preceding", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Type = bill.InvoiceTypeCreditNote
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-MYINVOIS-BILL-INVOICE-17]")
+	})
+}
+
+func TestConsolidatedInvoice(t *testing.T) {
+	inv := testInvoiceStandard(t)
+	inv.SetTags(my.TagConsolidated)
+	inv.Customer = nil
+	inv.Lines[0].Item.Ext = tax.Extensions{}
+	require.NoError(t, inv.Calculate())
+	require.NoError(t, rules.Validate(inv))
+	assert.Equal(t, my.TINGeneralPublic, inv.Customer.TaxID.Code)
+	assert.Equal(t, cbc.Code("01"), inv.Tax.Ext.Get(myinvois.ExtKeyDocType))
+}
@@ -0,0 +1,116 @@
+package myinvois
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Extension keys used by the addon.
+const (
+	ExtKeyDocType cbc.Key = "my-myinvois-doc-type"
+	ExtKeyTaxType cbc.Key = "my-myinvois-tax-type"
+)
+
+// Tax type codes.
+const (
+	TaxTypeSales         cbc.Code = "01"
+	TaxTypeService       cbc.Code = "02"
+	TaxTypeNotApplicable cbc.Code = "06"
+	TaxTypeExempt        cbc.Code = "E"
+)
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyDocType,
+		Name: i18n.String{
+			i18n.EN: "e-Invoice Type",
+			i18n.MS: "Jenis e-Invois",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Type of e-invoice submitted to MyInvois. GOBL determines the code automatically
+				from the invoice type and the ~self-billed~ tag. Refund notes have no
+				equivalent invoice type, so ~04~ must be set explicitly on a standard
+				invoice, and will be converted to ~14~ when self-billed.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "01",
+				Name: i18n.NewString("Invoice"),
+			},
+			{
+				Code: "02",
+				Name: i18n.NewString("Credit Note"),
+			},
+			{
+				Code: "03",
+				Name: i18n.NewString("Debit Note"),
+			},
+			{
+				Code: "04",
+				Name: i18n.NewString("Refund Note"),
+			},
+			{
+				Code: "11",
+				Name: i18n.NewString("Self-billed Invoice"),
+			},
+			{
+				Code: "12",
+				Name: i18n.NewString("Self-billed Credit Note"),
+			},
+			{
+				Code: "13",
+				Name: i18n.NewString("Self-billed Debit Note"),
+			},
+			{
+				Code: "14",
+				Name: i18n.NewString("Self-billed Refund Note"),
+			},
+		},
+	},
+	{
+		Key: ExtKeyTaxType,
+		Name: i18n.String{
+			i18n.EN: "Tax Type",
+			i18n.MS: "Jenis Cukai",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Type of tax applied to a line. GOBL sets ~01~ for the ~ST~ category, ~02~ for
+				the ~SVT~ category, and ~E~ for exempt combos automatically.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: TaxTypeSales,
+				Name: i18n.NewString("Sales Tax"),
+			},
+			{
+				Code: TaxTypeService,
+				Name: i18n.NewString("Service Tax"),
+			},
+			{
+				Code: "03",
+				Name: i18n.NewString("Tourism Tax"),
+			},
+			{
+				Code: "04",
+				Name: i18n.NewString("High-Value Goods Tax"),
+			},
+			{
+				Code: "05",
+				Name: i18n.NewString("Sales Tax on Low Value Goods"),
+			},
+			{
+				Code: TaxTypeNotApplicable,
+				Name: i18n.NewString("Not Applicable"),
+			},
+			{
+				Code: TaxTypeExempt,
+				Name: i18n.NewString("Tax exemption"),
+			},
+		},
+	},
+}
@@ -0,0 +1,84 @@
+// Package myinvois provides extensions and validations for the Malaysian
+// LHDN MyInvois e-invoicing system.
+package myinvois
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// Key identifies the MyInvois addon family. Individual versions append a
+	// suffix; the family key is used as the fault-code namespace so that
+	// rules that carry across versions keep stable codes.
+	Key cbc.Key = "my-myinvois"
+
+	// V1 is the key for version 1.x of the MyInvois e-invoice documents.
+	V1 cbc.Key = Key + "-v1"
+)
+
+func init() {
+	tax.RegisterAddonDef(newAddon())
+	rules.RegisterWithGuard(
+		Key.String(),
+		rules.GOBL.Add("MY-MYINVOIS"),
+		is.InContext(tax.AddonIn(V1)),
+		billInvoiceRules(),
+		orgAddressRules(),
+		orgItemRules(),
+		taxComboRules(),
+	)
+	norm.RegisterWithGuard(
+		is.InContext(tax.AddonIn(V1)),
+		norm.For(normalizeOrgAddress),
+		norm.For(normalizeTaxCombo),
+	)
+}
+
+func newAddon() *tax.AddonDef {
+	return &tax.AddonDef{
+		Key: V1,
+		Name: i18n.String{
+			i18n.EN: "Malaysia MyInvois v1.x",
+			i18n.MS: "Malaysia MyInvois v1.x",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Support for the e-invoices submitted to the MyInvois system of the Malaysian
+				Inland Revenue Board (LHDN).
+
+				The e-invoice type will be determined automatically from the invoice type
+				and the ~self-billed~ tag, and the tax type of each line from the tax
+				category, using ~01~ for sales tax, ~02~ for service tax, and ~E~ for
+				exemptions.
+
+				Both parties must be identified by their TIN along with a registration or
+				identity document (~BRN~, ~NRIC~, ~PASSPORT~ or ~ARMY~), an address and a
+				telephone number. Suppliers must also declare their MSIC code. Consolidated
+				e-invoices issued to the general public, tagged with ~consolidated~, only
+				require the general public TIN for the customer.
+
+				Malaysian addresses must set the state using the ISO 3166-2:MY code
+				without the country prefix, from ~01~ (Johor) to ~16~ (Putrajaya).
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("MyInvois SDK - e-Invoice Specification"),
+				URL:   "https://sdk.myinvois.hasil.gov.my/documents/invoice-v1-1/",
+			},
+			{
+				Title:       i18n.NewString("LHDN - e-Invoice Specific Guideline"),
+				URL:         "https://www.hasil.gov.my/media/arvlbzqh/irbm-e-invoice-specific-guideline.pdf",
+				ContentType: "application/pdf",
+			},
+		},
+		Extensions: extensions,
+		Scenarios:  scenarios,
+	}
+}
@@ -0,0 +1,71 @@
+package myinvois
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// states contains the ISO 3166-2:MY subdivision codes, which match the
+// state codes used by MyInvois.
+var states = []cbc.Code{
+	"01", // Johor
+	"02", // Kedah
+	"03", // Kelantan
+	"04", // Melaka
+	"05", // Negeri Sembilan
+	"06", // Pahang
+	"07", // Pulau Pinang
+	"08", // Perak
+	"09", // Perlis
+	"10", // Selangor
+	"11", // Terengganu
+	"12", // Sabah
+	"13", // Sarawak
+	"14", // Wilayah Persekutuan Kuala Lumpur
+	"15", // Wilayah Persekutuan Labuan
+	"16", // Wilayah Persekutuan Putrajaya
+}
+
+// normalizeOrgAddress removes the country prefix from Malaysian state
+// codes, so that "MY-10" becomes "10".
+func normalizeOrgAddress(addr *org.Address) {
+	if addr == nil || addr.Country != l10n.MY.ISO() {
+		return
+	}
+	addr.State = addr.State.TrimPrefix(cbc.Code(l10n.MY))
+}
+
+func orgAddressRules() *rules.Set {
+	return rules.For(new(org.Address),
+		rules.Field("locality",
+			rules.Assert("01", "address locality is required", is.Present),
+		),
+		rules.When(
+			is.Expr(`string(Country) == "MY"`),
+			rules.Field("state",
+				rules.Assert("02", "Malaysian address state is required", is.Present),
+				rules.AssertIfPresent("03", "Malaysian address state must be a valid ISO 3166-2:MY code",
+					cbc.InCodes(states...),
+				),
+			),
+		),
+	)
+}
+
+func orgItemRules() *rules.Set {
+	return rules.For(new(org.Item),
+		rules.Field("ext",
+			rules.Assert("01",
+				fmt.Sprintf("item requires '%s' extension", my.ExtKeyClassification),
+				tax.ExtensionsRequire(my.ExtKeyClassification),
+			),
+		),
+	)
+}
@@ -0,0 +1,63 @@
+package myinvois_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/my/myinvois"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgAddressValidation(t *testing.T) {
+	ctx := tax.AddonContext(myinvois.V1)
+	tests := []struct {
+		name string
+		addr *org.Address
+		err  string
+	}{
+		{
+			name: "valid",
+			addr: &org.Address{Locality: "Johor Bahru", State: "01", Country: "MY"},
+		},
+		{
+			name: "foreign without state",
+			addr: &org.Address{Locality: "Singapore", Country: "SG"},
+		},
+		{
+			name: "missing locality",
+			addr: &org.Address{State: "01", Country: "MY"},
+			err:  "[GOBL-MY-MYINVOIS-ORG-ADDRESS-01]",
+		},
+		{
+			name: "missing state",
+			addr: &org.Address{Locality: "Johor Bahru", Country: "MY"},
+			err:  "[GOBL-MY-MYINVOIS-ORG-ADDRESS-02]",
+		},
+		{
+			name: "invalid state",
+			addr: &org.Address{Locality: "Johor Bahru", State: "17", Country: "MY"},
+			err:  "[GOBL-MY-MYINVOIS-ORG-ADDRESS-03]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.Validate(tt.addr, ctx)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestOrgItemValidation(t *testing.T) {
+	ctx := tax.AddonContext(myinvois.V1)
+	item := &org.Item{Name: "Test"}
+	assert.ErrorContains(t, rules.Validate(item, ctx), "[GOBL-MY-MYINVOIS-ORG-ITEM-01]")
+	item.Ext = tax.ExtensionsOf(cbc.CodeMap{"my-classification": "022"})
+	assert.NoError(t, rules.Validate(item, ctx))
+}
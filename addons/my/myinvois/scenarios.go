@@ -0,0 +1,89 @@
+package myinvois
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/tax"
+)
+
+var scenarios = []*tax.ScenarioSet{
+	invoiceScenarios,
+}
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*tax.Scenario{
+		// ** e-Invoice Types **
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Filter: isNotRefundNote,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "01",
+			}),
+		},
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Filter: isRefundNote,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "04",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "02",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeDebitNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "03",
+			}),
+		},
+		// ** Self-billed e-Invoice Types **
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:   []cbc.Key{tax.TagSelfBilled},
+			Filter: isNotRefundNote,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "11",
+			}),
+		},
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:   []cbc.Key{tax.TagSelfBilled},
+			Filter: isRefundNote,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "14",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Tags:  []cbc.Key{tax.TagSelfBilled},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "12",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeDebitNote},
+			Tags:  []cbc.Key{tax.TagSelfBilled},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "13",
+			}),
+		},
+	},
+}
+
+// isRefundNote is true when the refund note type was set explicitly, as
+// there is no equivalent GOBL invoice type.
+func isRefundNote(doc any) bool {
+	inv, ok := doc.(*bill.Invoice)
+	if !ok || inv.Tax == nil {
+		return false
+	}
+	return inv.Tax.Ext.Get(ExtKeyDocType).In("04", "14")
+}
+
+func isNotRefundNote(doc any) bool {
+	return !isRefundNote(doc)
+}
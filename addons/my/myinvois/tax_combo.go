@@ -0,0 +1,45 @@
+package myinvois
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// normalizeTaxCombo sets the tax type from the combo's category and key,
+// unless already provided.
+func normalizeTaxCombo(tc *tax.Combo) {
+	if tc == nil || tc.Ext.Has(ExtKeyTaxType) {
+		return
+	}
+	var code cbc.Code
+	switch {
+	case tc.Key == tax.KeyExempt:
+		code = TaxTypeExempt
+	case tc.Category == tax.CategoryST:
+		code = TaxTypeSales
+	case tc.Category == my.TaxCategorySVT:
+		code = TaxTypeService
+	default:
+		return
+	}
+	tc.Ext = tc.Ext.Set(ExtKeyTaxType, code)
+}
+
+func taxComboRules() *rules.Set {
+	return rules.For(new(tax.Combo),
+		rules.Field("ext",
+			rules.Assert("01",
+				fmt.Sprintf("tax combo requires '%s' extension", ExtKeyTaxType),
+				tax.ExtensionsRequire(ExtKeyTaxType),
+			),
+			rules.Assert("02",
+				fmt.Sprintf("tax combo '%s' extension must be a valid tax type", ExtKeyTaxType),
+				tax.ExtensionHasValidCode(ExtKeyTaxType),
+			),
+		),
+	)
+}
@@ -0,0 +1,66 @@
+package myinvois_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/my/myinvois"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxComboNormalization(t *testing.T) {
+	tests := []struct {
+		name  string
+		combo *tax.Combo
+		code  cbc.Code
+	}{
+		{
+			name:  "sales tax",
+			combo: &tax.Combo{Category: tax.CategoryST, Rate: tax.RateGeneral},
+			code:  myinvois.TaxTypeSales,
+		},
+		{
+			name:  "service tax",
+			combo: &tax.Combo{Category: my.TaxCategorySVT, Rate: tax.RateGeneral},
+			code:  myinvois.TaxTypeService,
+		},
+		{
+			name:  "exempt",
+			combo: &tax.Combo{Category: tax.CategoryST, Key: tax.KeyExempt},
+			code:  myinvois.TaxTypeExempt,
+		},
+		{
+			name: "already set",
+			combo: &tax.Combo{
+				Category: tax.CategoryST,
+				Ext:      tax.ExtensionsOf(cbc.CodeMap{myinvois.ExtKeyTaxType: "05"}),
+			},
+			code: "05",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			norm.Normalize(tt.combo, tax.AddonContext(myinvois.V1))
+			assert.Equal(t, tt.code, tt.combo.Ext.Get(myinvois.ExtKeyTaxType))
+		})
+	}
+}
+
+func TestTaxComboValidation(t *testing.T) {
+	ctx := tax.AddonContext(myinvois.V1)
+	t.Run("missing tax type", func(t *testing.T) {
+		tc := &tax.Combo{Category: "OTHER"}
+		assert.ErrorContains(t, rules.Validate(tc, ctx), "[GOBL-MY-MYINVOIS-TAX-COMBO-01]")
+	})
+	t.Run("invalid tax type", func(t *testing.T) {
+		tc := &tax.Combo{
+			Category: tax.CategoryST,
+			Ext:      tax.ExtensionsOf(cbc.CodeMap{myinvois.ExtKeyTaxType: "07"}),
+		}
+		assert.ErrorContains(t, rules.Validate(tc, ctx), "[GOBL-MY-MYINVOIS-TAX-COMBO-02]")
+	})
+}
@@ -0,0 +1,81 @@
+package efactura
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/catalogues/untdid"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// BR-RO-020 - restricted subset of UNTDID document type codes
+var validInvoiceUNTDIDDocumentTypeValues = []cbc.Code{
+	"380", // Commercial invoice
+	"384", // Corrected invoice
+	"389", // Self-billed invoice
+	"381", // Credit note
+	"751", // Invoice information for accounting purposes
+}
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.Field("code",
+			rules.AssertIfPresent("01", "invoice code must contain at least one digit (BR-RO-010)", is.Matches(`\d`)),
+		),
+		rules.Field("tax",
+			rules.Field("ext",
+				rules.Assert("02", "tax ext must have a valid UNTDID document type code (BR-RO-020)",
+					tax.ExtensionsHasCodes(untdid.ExtKeyDocumentType, validInvoiceUNTDIDDocumentTypeValues...),
+				),
+			),
+		),
+		rules.Assert("03", "invoice must be in RON or provide exchange rate for conversion (BR-RO-030)",
+			currency.CanConvertTo(currency.RON),
+		),
+		rules.Field("supplier",
+			rules.Field("tax_id",
+				rules.Assert("04", "supplier tax ID is required", is.Present),
+			),
+			rules.Field("addresses",
+				rules.Assert("05", "supplier addresses are required", is.Present),
+			),
+		),
+		rules.Field("customer",
+			rules.Assert("06", "customer tax ID or identity is required (BR-RO-120)",
+				is.Func("identified", partyIsIdentified),
+			),
+			rules.Field("addresses",
+				rules.Assert("07", "customer addresses are required", is.Present),
+			),
+		),
+		rules.Field("lines",
+			rules.Assert("08", "invoice cannot have more than 999 lines (BR-RO-A999)", is.Length(0, 999)),
+			rules.Each(
+				rules.Field("item",
+					rules.Field("name",
+						rules.AssertIfPresent("09", "line item name cannot be longer than 100 characters (BR-RO-L100)",
+							is.RuneLength(0, 100),
+						),
+					),
+				),
+			),
+		),
+		rules.Field("notes",
+			rules.Assert("10", "invoice cannot have more than 20 notes (BR-RO-A020)", is.Length(0, 20)),
+		),
+	)
+}
+
+func partyIsIdentified(val any) bool {
+	p, ok := val.(*org.Party)
+	if !ok || p == nil {
+		return true // handled by en16931
+	}
+	if p.TaxID != nil && p.TaxID.Code != cbc.CodeEmpty {
+		return true
+	}
+	return len(p.Identities) > 0
+}
@@ -0,0 +1,172 @@
+package efactura_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/invopop/gobl/addons/ro/efactura"
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/catalogues/untdid"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pay"
+	_ "github.com/invopop/gobl/regimes/ro"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInvoiceStandard(t *testing.T) *bill.Invoice {
+	t.Helper()
+	return &bill.Invoice{
+		Regime:    tax.WithRegime("RO"),
+		Addons:    tax.WithAddons(efactura.V1),
+		IssueDate: cal.MakeDate(2025, 9, 1),
+		Series:    "FCT",
+		Code:      "0001",
+		Supplier: &org.Party{
+			Name: "Furnizor S.R.L.",
+			TaxID: &tax.Identity{
+				Country: "RO",
+				Code:    "18547290",
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Calea Victoriei",
+					Number:   "10",
+					Locality: "Sector 1",
+					Region:   "București",
+					Code:     "010061",
+					Country:  "RO",
+				},
+			},
+		},
+		Customer: &org.Party{
+			Name: "Client S.A.",
+			TaxID: &tax.Identity{
+				Country: "RO",
+				Code:    "14399840",
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Strada Memorandumului",
+					Number:   "28",
+					Locality: "Cluj-Napoca",
+					State:    "RO-CJ",
+					Code:     "400114",
+					Country:  "RO",
+				},
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(20, 0),
+				Item: &org.Item{
+					Name:  "Servicii de dezvoltare software",
+					Price: num.NewAmount(25000, 2),
+					Unit:  org.UnitHour,
+				},
+				Taxes: tax.Set{
+					{
+						Category: "VAT",
+						Rate:     "general",
+					},
+				},
+			},
+		},
+		Payment: &bill.PaymentDetails{
+			Terms: &pay.Terms{
+				Notes: "Plata în 30 de zile",
+			},
+		},
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("valid invoice", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		assert.Equal(t, "380", inv.Tax.Ext.Get(untdid.ExtKeyDocumentType).String())
+	})
+
+	t.Run("code without digits", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Code = "ABC"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-01]")
+	})
+
+	t.Run("unsupported document type", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		inv.Tax.Ext = inv.Tax.Ext.Set(untdid.ExtKeyDocumentType, "326")
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-02]")
+	})
+
+	t.Run("missing customer identification", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-06]")
+	})
+
+	t.Run("customer identified by identity", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.TaxID = nil
+		inv.Customer.Identities = []*org.Identity{
+			{Code: "1800101221144"},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+
+	t.Run("missing customer addresses", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Addresses = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-07]")
+	})
+
+	t.Run("long item name", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Lines[0].Item.Name = strings.Repeat("ă", 101)
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-09]")
+	})
+
+	t.Run("too many notes", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		for i := 0; i < 21; i++ {
+			inv.Notes = append(inv.Notes, &org.Note{Text: "Notă"})
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-10]")
+	})
+}
+
+func TestInvoiceCurrencyValidation(t *testing.T) {
+	t.Run("non-RON currency without exchange rates", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Currency = "EUR"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-BILL-INVOICE-03]")
+	})
+
+	t.Run("non-RON currency with exchange rates", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Currency = "EUR"
+		inv.ExchangeRates = []*currency.ExchangeRate{
+			{
+				From:   "EUR",
+				To:     "RON",
+				Amount: num.MakeAmount(50812, 4),
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+}
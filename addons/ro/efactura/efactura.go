@@ -0,0 +1,74 @@
+// Package efactura provides extensions and validations for the Romanian
+// RO_CIUS profile of EN 16931 used by the ANAF e-Factura system.
+package efactura
+
+import (
+	"github.com/invopop/gobl/addons/eu/en16931"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// Key identifies the e-Factura addon family. Individual versions append
+	// a suffix; the family key is used as the fault-code namespace so that
+	// rules that carry across versions keep stable codes.
+	Key cbc.Key = "ro-efactura"
+
+	// V1 is the key for the RO_CIUS 1.0.1 specification used by e-Factura.
+	V1 cbc.Key = Key + "-v1"
+)
+
+func init() {
+	tax.RegisterAddonDef(newAddon())
+	rules.RegisterWithGuard(
+		Key.String(),
+		rules.GOBL.Add("RO-EFACTURA"),
+		is.InContext(tax.AddonIn(V1)),
+		billInvoiceRules(),
+		orgAddressRules(),
+	)
+	norm.RegisterWithGuard(
+		is.InContext(tax.AddonIn(V1)),
+		norm.For(normalizeOrgAddress),
+	)
+}
+
+func newAddon() *tax.AddonDef {
+	return &tax.AddonDef{
+		Key: V1,
+		Name: i18n.String{
+			i18n.EN: "Romania e-Factura (RO_CIUS)",
+			i18n.RO: "România e-Factura (RO_CIUS)",
+		},
+		Requires: []cbc.Key{
+			en16931.V2017,
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Support for the Romanian RO_CIUS specification of EN 16931, mandatory for
+				invoices submitted to the ANAF e-Factura system for B2G and B2B operations.
+
+				Romanian addresses must define the county in the address state field using
+				the ISO 3166-2:RO subdivision code without the country prefix, such as
+				"CJ" for Cluj or "B" for Bucharest. County names in the region field will
+				be converted automatically. Addresses in Bucharest must use the sector
+				("SECTOR1" to "SECTOR6") as the locality, which will be normalized from
+				common forms such as "Sector 1" or "Sectorul 1".
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "ANAF - e-Factura RO_CIUS specification",
+					i18n.RO: "ANAF - Specificații tehnice RO_CIUS",
+				},
+				URL: "https://mfinante.gov.ro/ro/web/efactura/informatii-tehnice",
+			},
+		},
+	}
+}
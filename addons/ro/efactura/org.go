@@ -0,0 +1,160 @@
+package efactura
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+)
+
+// StateCodeBucharest is the ISO 3166-2:RO subdivision code for the
+// municipality of Bucharest.
+const StateCodeBucharest cbc.Code = "B"
+
+// counties maps the ISO 3166-2:RO subdivision codes to their names, without
+// diacritics, as used to match the address region.
+var counties = map[cbc.Code]string{
+	"AB":               "Alba",
+	"AR":               "Arad",
+	"AG":               "Arges",
+	"BC":               "Bacau",
+	"BH":               "Bihor",
+	"BN":               "Bistrita-Nasaud",
+	"BT":               "Botosani",
+	"BV":               "Brasov",
+	"BR":               "Braila",
+	"BZ":               "Buzau",
+	"CS":               "Caras-Severin",
+	"CL":               "Calarasi",
+	"CJ":               "Cluj",
+	"CT":               "Constanta",
+	"CV":               "Covasna",
+	"DB":               "Dambovita",
+	"DJ":               "Dolj",
+	"GL":               "Galati",
+	"GR":               "Giurgiu",
+	"GJ":               "Gorj",
+	"HR":               "Harghita",
+	"HD":               "Hunedoara",
+	"IL":               "Ialomita",
+	"IS":               "Iasi",
+	"IF":               "Ilfov",
+	"MM":               "Maramures",
+	"MH":               "Mehedinti",
+	"MS":               "Mures",
+	"NT":               "Neamt",
+	"OT":               "Olt",
+	"PH":               "Prahova",
+	"SM":               "Satu Mare",
+	"SJ":               "Salaj",
+	"SB":               "Sibiu",
+	"SV":               "Suceava",
+	"TR":               "Teleorman",
+	"TM":               "Timis",
+	"TL":               "Tulcea",
+	"VS":               "Vaslui",
+	"VL":               "Valcea",
+	"VN":               "Vrancea",
+	StateCodeBucharest: "Bucuresti",
+}
+
+// Alternative names used for Bucharest.
+var bucharestNames = []string{"bucharest", "municipiul bucuresti"}
+
+// Bucharest addresses use the sector as the locality.
+var (
+	sectorRegexp    = regexp.MustCompile(`(?i)\bsector(?:ul)?\s*([1-6])\b`)
+	validSectors    = []any{"SECTOR1", "SECTOR2", "SECTOR3", "SECTOR4", "SECTOR5", "SECTOR6"}
+	diacriticsFixer = strings.NewReplacer(
+		"ă", "a", "â", "a", "î", "i", "ș", "s", "ş", "s", "ț", "t", "ţ", "t",
+		"Ă", "A", "Â", "A", "Î", "I", "Ș", "S", "Ş", "S", "Ț", "T", "Ţ", "T",
+	)
+)
+
+func normalizeOrgAddress(addr *org.Address) {
+	if addr == nil || addr.Country != l10n.RO.ISO() {
+		return
+	}
+	addr.State = normalizeStateCode(addr.State)
+	if addr.State == cbc.CodeEmpty {
+		addr.State = stateCodeFromName(addr.Region)
+	}
+	if addr.State == StateCodeBucharest {
+		if m := sectorRegexp.FindStringSubmatch(addr.Locality); m != nil {
+			addr.Locality = "SECTOR" + m[1]
+		}
+	}
+}
+
+// normalizeStateCode removes the country prefix from ISO 3166-2 codes.
+func normalizeStateCode(code cbc.Code) cbc.Code {
+	code = cbc.NormalizeAlphanumericalCode(code)
+	if _, ok := counties[code]; ok {
+		return code
+	}
+	if c := code[min(len(code), 2):]; strings.HasPrefix(code.String(), "RO") {
+		if _, ok := counties[c]; ok {
+			return c
+		}
+	}
+	return code
+}
+
+func stateCodeFromName(name string) cbc.Code {
+	name = strings.ToLower(strings.TrimSpace(diacriticsFixer.Replace(name)))
+	if name == "" {
+		return cbc.CodeEmpty
+	}
+	for _, n := range bucharestNames {
+		if name == n {
+			return StateCodeBucharest
+		}
+	}
+	for code, n := range counties {
+		if strings.ToLower(n) == name {
+			return code
+		}
+	}
+	return cbc.CodeEmpty
+}
+
+func orgAddressRules() *rules.Set {
+	return rules.For(new(org.Address),
+		rules.When(
+			is.Expr(`string(Country) == "RO"`),
+			rules.Field("street",
+				rules.Assert("01", "Romanian address street is required (BR-RO-080)", is.Present),
+			),
+			rules.Field("locality",
+				rules.Assert("02", "Romanian address locality is required (BR-RO-090)", is.Present),
+			),
+			rules.Field("state",
+				rules.Assert("03", "Romanian address state is required with the county code (BR-RO-110)", is.Present),
+				rules.AssertIfPresent("04", "Romanian address state must be a valid ISO 3166-2:RO county code (BR-RO-110)",
+					is.Func("valid county", isValidCountyCode),
+				),
+			),
+			rules.When(
+				is.Expr(`string(State) == "B"`),
+				rules.Field("locality",
+					rules.AssertIfPresent("05", "Bucharest address locality must be a sector from SECTOR1 to SECTOR6 (BR-RO-100)",
+						is.In(validSectors...),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isValidCountyCode(val any) bool {
+	code, ok := val.(cbc.Code)
+	if !ok {
+		return false
+	}
+	_, ok = counties[code]
+	return ok
+}
@@ -0,0 +1,115 @@
+package efactura_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/addons/ro/efactura"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressNormalization(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     *org.Address
+		state    cbc.Code
+		locality string
+	}{
+		{
+			name:     "ISO code with prefix",
+			addr:     &org.Address{Country: "RO", State: "RO-CJ", Locality: "Cluj-Napoca"},
+			state:    "CJ",
+			locality: "Cluj-Napoca",
+		},
+		{
+			name:     "county name with diacritics",
+			addr:     &org.Address{Country: "RO", Region: "Timiș", Locality: "Timișoara"},
+			state:    "TM",
+			locality: "Timișoara",
+		},
+		{
+			name:     "Bucharest sector",
+			addr:     &org.Address{Country: "RO", Region: "Bucharest", Locality: "Sectorul 3"},
+			state:    efactura.StateCodeBucharest,
+			locality: "SECTOR3",
+		},
+		{
+			name:     "Bucharest sector with prefix",
+			addr:     &org.Address{Country: "RO", State: "RO-B", Locality: "București, Sector 6"},
+			state:    efactura.StateCodeBucharest,
+			locality: "SECTOR6",
+		},
+		{
+			name:     "sector outside Bucharest",
+			addr:     &org.Address{Country: "RO", State: "IF", Locality: "Sector 1"},
+			state:    "IF",
+			locality: "Sector 1",
+		},
+		{
+			name:     "other country",
+			addr:     &org.Address{Country: "HU", Region: "Cluj", Locality: "Sector 1"},
+			state:    "",
+			locality: "Sector 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			norm.Normalize(tt.addr, tax.AddonContext(efactura.V1))
+			assert.Equal(t, tt.state, tt.addr.State)
+			assert.Equal(t, tt.locality, tt.addr.Locality)
+		})
+	}
+}
+
+func TestInvoiceAddressValidation(t *testing.T) {
+	t.Run("normalized Bucharest address", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		require.NoError(t, inv.Calculate())
+		addr := inv.Supplier.Addresses[0]
+		assert.Equal(t, efactura.StateCodeBucharest, addr.State)
+		assert.Equal(t, "SECTOR1", addr.Locality)
+		assert.Equal(t, cbc.Code("CJ"), inv.Customer.Addresses[0].State)
+		require.NoError(t, rules.Validate(inv))
+	})
+
+	t.Run("missing street", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Addresses[0].Street = ""
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-ORG-ADDRESS-01]")
+	})
+
+	t.Run("missing county", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Addresses[0].State = ""
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-ORG-ADDRESS-03]")
+	})
+
+	t.Run("invalid county", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.Addresses[0].State = "XX"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-ORG-ADDRESS-04]")
+	})
+
+	t.Run("Bucharest without sector", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Supplier.Addresses[0].Locality = "București"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-EFACTURA-ORG-ADDRESS-05]")
+	})
+
+	t.Run("foreign address", func(t *testing.T) {
+		inv := testInvoiceStandard(t)
+		inv.Customer.TaxID = &tax.Identity{Country: "DE", Code: "111111125"}
+		inv.Customer.Addresses[0] = &org.Address{Locality: "Berlin", Country: "DE"}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+}
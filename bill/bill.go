@@ -2,6 +2,7 @@
 package bill
 
 import (
+	"github.com/invopop/gobl/cal"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/schema"
 )
@@ -9,6 +10,7 @@ import (
 func init() {
 	schema.Register(schema.GOBL.Add("bill"),
 		// Primary schemas
+		Certificate{},
 		CorrectionOptions{},
 		Delivery{},
 		Invoice{},
@@ -17,6 +19,7 @@ func init() {
 		Status{},
 		// Sub-schemas - used by primaries
 		Action{},
+		CertificateLine{},
 		Charge{},
 		DeliveryDetails{},
 		Discount{},
@@ -36,6 +39,7 @@ func init() {
 		deliveryRules(),
 		orderRules(),
 		paymentRules(),
+		certificateRules(),
 		lineRules(),
 		subLineRules(),
 		lineDiscountRules(),
@@ -43,6 +47,7 @@ func init() {
 		discountRules(),
 		chargeRules(),
 		paymentLineRules(),
+		certificateLineRules(),
 		taxRules(),
 		totalsRules(),
 		statusRules(),
@@ -55,9 +60,20 @@ func init() {
 
 // Constants used to help identify document schemas
 const (
-	ShortSchemaOrder    = "bill/order"
-	ShortSchemaDelivery = "bill/delivery"
-	ShortSchemaInvoice  = "bill/invoice"
-	ShortSchemaPayment  = "bill/payment"
-	ShortSchemaStatus   = "bill/status"
+	ShortSchemaOrder       = "bill/order"
+	ShortSchemaDelivery    = "bill/delivery"
+	ShortSchemaInvoice     = "bill/invoice"
+	ShortSchemaPayment     = "bill/payment"
+	ShortSchemaStatus      = "bill/status"
+	ShortSchemaCertificate = "bill/certificate"
 )
+
+// issueDateContext adds a document's issue date to the validation context,
+// if set, to be used with effective-dated rules.
+func issueDateContext(date cal.Date) rules.WithContext {
+	return func(rc *rules.Context) {
+		if !date.IsZero() {
+			rules.WithDate(date.Date)(rc)
+		}
+	}
+}
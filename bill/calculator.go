@@ -12,6 +12,14 @@ import (
 	"github.com/invopop/gobl/tax"
 )
 
+// Origins of the changes recorded while calculating documents that are not
+// made by normalizers.
+const (
+	originRegime    = "bill: regime from supplier"
+	originDefaults  = "bill: default values"
+	originScenarios = "bill: scenarios"
+)
+
 // billable defines the methods required to be able to perform calculations and
 // other operations on a bill document with a common basic structure.
 type billable interface {
@@ -30,6 +38,8 @@ type billable interface {
 	getIssueDate() cal.Date
 	getIssueTime() *cal.Time
 	getValueDate() *cal.Date
+	getOperationDate() *cal.Date
+	getDeliveryDate() *cal.Date
 	getTax() *Tax
 	getPreceding() []*org.DocumentRef
 	getCustomer() *org.Party
@@ -48,16 +58,11 @@ type billable interface {
 }
 
 func calculate(doc billable) error {
-	r := doc.RegimeDef() // may be nil!
-	date := calculateIssueDateAndTime(r, doc)
-
-	// Convert empty or invalid currency to the regime's currency
-	if doc.GetCurrency() == currency.CodeEmpty || doc.GetCurrency().Def() == nil {
-		if r == nil {
-			return fmt.Errorf("currency: missing or invalid")
-		}
-		doc.setCurrency(r.Currency)
+	if err := calculateDefaults(doc); err != nil {
+		return err
 	}
+	r := doc.RegimeDef() // may be nil!
+	date := taxDate(doc)
 	cur := doc.GetCurrency()
 
 	if doc.HasTags(tax.TagBypass) {
@@ -123,7 +128,8 @@ func calculate(doc billable) error {
 	}
 
 	tls := prepareTaxableLines(doc)
-	if len(tls) == 0 {
+	mls := prepareMarginLines(doc, cur)
+	if len(tls) == 0 && len(mls) == 0 {
 		// This applies for orders and deliveries that might not have
 		// any pricing details.
 		doc.setTotals(nil)
@@ -136,7 +142,7 @@ func calculate(doc billable) error {
 		Currency: doc.GetCurrency(),
 		Rounding: rr,
 		Country:  r.GetCountry(),
-		Date:     *date,
+		Date:     date,
 		Lines:    tls,
 		Includes: pit,
 	}
@@ -152,6 +158,21 @@ func calculate(doc billable) error {
 		t.Total = t.Total.Subtract(ti)
 	}
 
+	// Margin scheme lines include non-disclosed taxes on the margin only.
+	if len(mls) > 0 {
+		mc := &tax.TotalCalculator{
+			Currency: doc.GetCurrency(),
+			Rounding: rr,
+			Country:  r.GetCountry(),
+			Date:     date,
+			Lines:    mls,
+			Includes: pit,
+		}
+		if err := calculateMarginTotals(t, mc, r); err != nil {
+			return fmt.Errorf("margin: %w", err)
+		}
+	}
+
 	// Calculate the total with *all* the taxes.
 	t.Tax = t.Taxes.Sum
 	t.TotalWithTax = t.Total.Add(t.Tax)
@@ -206,7 +227,7 @@ func prepareTaxableLines(doc billable) []tax.TaxableLine {
 	// Build list of taxable lines
 	tls := make([]tax.TaxableLine, 0)
 	for _, l := range doc.getLines() {
-		if l != nil && l.Total != nil {
+		if l != nil && l.Total != nil && !isMarginLine(doc, l) {
 			tls = append(tls, l)
 		}
 	}
@@ -223,7 +244,10 @@ func prepareTaxableLines(doc billable) []tax.TaxableLine {
 	return tls
 }
 
-func calculateIssueDateAndTime(r *tax.RegimeDef, doc billable) *cal.Date {
+// calculateDefaults sets the issue date, and time if requested, alongside
+// the currency when missing, using the regime's time zone and currency.
+func calculateDefaults(doc billable) error {
+	r := doc.RegimeDef() // may be nil!
 	tz := r.TimeLocation()
 	if doc.getIssueTime() != nil && doc.getIssueTime().IsZero() {
 		dn := cal.ThisSecondIn(tz)
@@ -234,14 +258,52 @@ func calculateIssueDateAndTime(r *tax.RegimeDef, doc billable) *cal.Date {
 		doc.setIssueDate(cal.TodayIn(tz))
 	}
 
-	// Get the date used for tax calculations
-	date := doc.getValueDate()
-	if date == nil {
-		id := doc.getIssueDate()
-		date = &id
+	// Convert empty or invalid currency to the regime's currency
+	if doc.GetCurrency() == currency.CodeEmpty || doc.GetCurrency().Def() == nil {
+		if r == nil {
+			return fmt.Errorf("currency: missing or invalid")
+		}
+		doc.setCurrency(r.Currency)
+	}
+	return nil
+}
+
+// taxDate determines the date on which the document's taxes become
+// accountable, using the value date, tax point, or issue date.
+func taxDate(doc billable) cal.Date {
+	if d := doc.getValueDate(); d != nil {
+		return *d
 	}
+	if d := taxPointDate(doc); d != nil {
+		return *d
+	}
+	return doc.getIssueDate()
+}
 
-	return date
+// taxPointDate tries to determine the date on which the tax liability was
+// triggered according to the tax point defined in the document. Delivery
+// points will use the delivery date, payment points the date of the first
+// advance, and if neither are available, the operation date will be used.
+// A nil response implies the issue date should be used.
+func taxPointDate(doc billable) *cal.Date {
+	tx := doc.getTax()
+	if tx == nil {
+		return nil
+	}
+	switch tx.Point {
+	case tax.PointDelivery:
+		if d := doc.getDeliveryDate(); d != nil {
+			return d
+		}
+	case tax.PointPayment:
+		if d := doc.getPaymentDetails().firstAdvanceDate(); d != nil {
+			return d
+		}
+	default:
+		// includes tax.PointIssue
+		return nil
+	}
+	return doc.getOperationDate()
 }
 
 func calculateOrgDocumentRefs(drs []*org.DocumentRef, cur currency.Code, rr cbc.Key) {
@@ -271,6 +333,11 @@ func removeIncludedTaxes(doc billable) error {
 	doc.setTotals(new(Totals))
 	lines := doc.getLines()
 	for i, l := range doc.getLines() {
+		if isMarginLine(doc, l) {
+			// taxes on the margin are never disclosed, so prices must
+			// continue to include them.
+			continue
+		}
 		lines[i] = removeLineIncludedTaxes(l, tpi)
 	}
 
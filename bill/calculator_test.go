@@ -319,3 +319,96 @@ func TestRemoveIncludedTaxes(t *testing.T) {
 		assert.Equal(t, "8.26", inv.Totals.Charge.String())
 	})
 }
+
+func TestCalculateTaxPointDate(t *testing.T) {
+	rate := func(inv *bill.Invoice) string {
+		return inv.Totals.Taxes.Categories[0].Rates[0].Percent.String()
+	}
+	t.Run("issue point", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointIssue
+		inv.OperationDate = cal.NewDate(2011, 1, 1)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "21.0%", rate(inv))
+	})
+	t.Run("delivery point with date", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointDelivery
+		inv.Delivery = &bill.DeliveryDetails{
+			Date: cal.NewDate(2011, 1, 1),
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "18.0%", rate(inv))
+	})
+	t.Run("delivery point with period", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointDelivery
+		inv.Delivery = &bill.DeliveryDetails{
+			Period: &cal.Period{
+				Start: cal.MakeDate(2010, 12, 1),
+				End:   cal.MakeDate(2010, 12, 31),
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "18.0%", rate(inv))
+	})
+	t.Run("delivery point with operation date", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointDelivery
+		inv.OperationDate = cal.NewDate(2009, 1, 1)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "16.0%", rate(inv))
+	})
+	t.Run("delivery point without dates", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointDelivery
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "21.0%", rate(inv))
+	})
+	t.Run("payment point with advances", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointPayment
+		inv.Payment = &bill.PaymentDetails{
+			Advances: []*pay.Record{
+				{
+					Date:        cal.NewDate(2012, 10, 1),
+					Description: "Second payment",
+					Amount:      num.MakeAmount(1000, 2),
+				},
+				nil,
+				{
+					Date:        cal.NewDate(2011, 1, 1),
+					Description: "First payment",
+					Amount:      num.MakeAmount(1000, 2),
+				},
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "18.0%", rate(inv))
+	})
+	t.Run("payment point without advance dates", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointPayment
+		inv.OperationDate = cal.NewDate(2011, 1, 1)
+		inv.Payment = &bill.PaymentDetails{
+			Advances: []*pay.Record{
+				{
+					Description: "Payment",
+					Amount:      num.MakeAmount(1000, 2),
+				},
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "18.0%", rate(inv))
+	})
+	t.Run("value date takes priority", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		inv.Tax.Point = tax.PointDelivery
+		inv.ValueDate = cal.NewDate(2009, 1, 1)
+		inv.Delivery = &bill.DeliveryDetails{
+			Date: cal.NewDate(2011, 1, 1),
+		}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "16.0%", rate(inv))
+	})
+}
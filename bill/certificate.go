@@ -0,0 +1,293 @@
+package bill
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/schema"
+	"github.com/invopop/gobl/tax"
+	"github.com/invopop/gobl/uuid"
+	"github.com/invopop/jsonschema"
+)
+
+// Predefined list of the certificate types supported.
+const (
+	CertificateTypeWithholding cbc.Key = "withholding"
+)
+
+// CertificateTypes defines the list of potential certificate types.
+var CertificateTypes = []*cbc.Definition{
+	{
+		Key: CertificateTypeWithholding,
+		Name: i18n.String{
+			i18n.EN: "Withholding",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				A withholding certificate issued by the customer, acting as the withholding
+				agent, to the supplier, reflecting the taxes retained from the supplier's
+				invoices during a given period.
+			`),
+		},
+	},
+}
+
+var isValidCertificateType = cbc.InKeyDefs(CertificateTypes)
+
+// A Certificate is used to summarize the taxes retained by a customer from the
+// invoices issued by a supplier during a period of time, typically used by
+// suppliers to justify the withholdings in their own tax returns.
+type Certificate struct {
+	tax.Regime
+	tax.Addons
+	uuid.Identify
+
+	// Type of certificate document being issued.
+	Type cbc.Key `json:"type" jsonschema:"title=Type" jsonschema_extras:"calculated=true,defaulted=true"`
+
+	// Series is used to identify groups of certificates by date, business area, project,
+	// type, or other company specific data.
+	Series cbc.Code `json:"series,omitempty" jsonschema:"title=Series"`
+	// Code is a sequential identifier that uniquely identifies the certificate. The code can
+	// be left empty initially, but is **required** to **sign** the document.
+	Code cbc.Code `json:"code,omitempty" jsonschema:"title=Code"`
+	// When the certificate was issued.
+	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true,defaulted=true"`
+	// Period of time covered by the certificate.
+	Period cal.Period `json:"period" jsonschema:"title=Period"`
+	// Currency for all certificate totals.
+	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true,defaulted=true"`
+	// Extensions for additional codes that may be required.
+	Ext tax.Extensions `json:"ext,omitzero" jsonschema:"title=Extensions"`
+
+	// The party whose invoices were subject to withholding.
+	Supplier *org.Party `json:"supplier" jsonschema:"title=Supplier"`
+	// The party who retained the taxes and issues the certificate.
+	Customer *org.Party `json:"customer" jsonschema:"title=Customer"`
+
+	// List of documents with retained taxes covered by the certificate.
+	Lines []*CertificateLine `json:"lines" jsonschema:"title=Lines"`
+
+	// Summary of the retained taxes from all the lines (calculated).
+	Tax *tax.Total `json:"tax,omitempty" jsonschema:"title=Tax" jsonschema_extras:"calculated=true"`
+	// Total amount retained (calculated).
+	Total num.Amount `json:"total" jsonschema:"title=Total" jsonschema_extras:"calculated=true"`
+
+	// Unstructured information that is relevant to the certificate.
+	Notes []*org.Note `json:"notes,omitempty" jsonschema:"title=Notes"`
+
+	// Additional semi-structured data that doesn't fit into the body of the certificate.
+	Meta cbc.Meta `json:"meta,omitempty" jsonschema:"title=Meta"`
+}
+
+// CanSign returns a boolean indicating whether the certificate is ready to be signed
+// or not.
+func (crt *Certificate) CanSign() bool {
+	return crt != nil && !crt.Code.IsEmpty()
+}
+
+func normalizeCertificate(crt *Certificate) {
+	if crt.Type == cbc.KeyEmpty {
+		crt.Type = CertificateTypeWithholding
+	}
+}
+
+func certificateRules() *rules.Set {
+	return rules.For(new(Certificate),
+		rules.Field("type",
+			rules.Assert("01", "certificate type is required", is.Present),
+			rules.Assert("02", "certificate type is not valid", isValidCertificateType),
+		),
+		rules.Field("issue_date",
+			rules.Assert("03", "certificate issue date is required", cal.DateNotZero()),
+		),
+		rules.Field("period",
+			rules.Assert("04", "certificate period is required", is.Present),
+		),
+		rules.Field("currency",
+			rules.Assert("05", "certificate currency is required", is.Present),
+		),
+		rules.Field("supplier",
+			rules.Assert("06", "certificate supplier is required", is.Present),
+			rules.Field("tax_id",
+				rules.Assert("10", "certificate supplier tax ID is required", is.Present),
+				rules.Field("code",
+					rules.Assert("11", "certificate supplier tax ID code is required", is.Present),
+				),
+			),
+		),
+		rules.Field("customer",
+			rules.Assert("07", "certificate customer is required", is.Present),
+			rules.Field("tax_id",
+				rules.Assert("12", "certificate customer tax ID is required", is.Present),
+				rules.Field("code",
+					rules.Assert("13", "certificate customer tax ID code is required", is.Present),
+				),
+			),
+		),
+		rules.Field("lines",
+			rules.Assert("08", "certificate lines are required", is.Present),
+		),
+		rules.Assert("09", "certificate taxes must be retained categories in the regime",
+			is.FuncError("retained categories", certificateTaxesRetained),
+		),
+	)
+}
+
+func certificateTaxesRetained(val any) error {
+	crt, ok := val.(*Certificate)
+	if !ok || crt == nil || crt.Tax == nil {
+		return nil
+	}
+	r := crt.RegimeDef()
+	if r == nil {
+		return nil
+	}
+	invalid := make([]string, 0)
+	for _, ct := range crt.Tax.Categories {
+		if cd := r.CategoryDef(ct.Code); cd == nil || !cd.Retained {
+			invalid = append(invalid, ct.Code.String())
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("not retained: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// RulesContext adds the certificate's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (crt *Certificate) RulesContext() rules.WithContext {
+	return issueDateContext(crt.IssueDate)
+}
+
+// Calculate performs all the normalizations and calculations required for the
+// certificate totals.
+func (crt *Certificate) Calculate() error {
+	return crt.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the certificate's inputs: the regime determined from the supplier,
+// normalizations, and default values like the issue date and currency.
+// Calculated amounts and totals are not recorded.
+func (crt *Certificate) CalculateWithRecorder(rec *norm.Recorder) error {
+	return crt.calculate(rec)
+}
+
+func (crt *Certificate) calculate(rec *norm.Recorder) error {
+	// Try to set Regime if not already prepared from the supplier's tax ID
+	if crt.Regime.IsEmpty() {
+		_ = rec.Record(crt, originRegime, func() error {
+			crt.SetRegime(partyTaxCountry(crt.Supplier))
+			return nil
+		})
+	}
+	norm.Normalize(crt, norm.WithRecorder(rec))
+	if err := rec.Record(crt, originDefaults, crt.calculateDefaults); err != nil {
+		return err
+	}
+	return crt.calculateTotals()
+}
+
+// calculateDefaults sets the issue date and currency when missing.
+func (crt *Certificate) calculateDefaults() error {
+	r := crt.RegimeDef()
+
+	if crt.IssueDate.IsZero() {
+		crt.IssueDate = cal.TodayIn(r.TimeLocation())
+	}
+
+	// Convert empty or invalid currency to the regime's currency
+	if crt.Currency == currency.CodeEmpty || crt.Currency.Def() == nil {
+		if r == nil {
+			return fmt.Errorf("currency: missing or invalid")
+		}
+		crt.Currency = r.Currency
+	}
+	return nil
+}
+
+func (crt *Certificate) calculateTotals() error {
+	zero := crt.Currency.Def().Zero()
+
+	t := &tax.Total{Sum: zero}
+	for i, l := range crt.Lines {
+		if l == nil {
+			continue
+		}
+		l.Index = i + 1
+		l.calculate(crt.Currency)
+		if l.Tax != nil {
+			t = t.Merge(l.Tax)
+		}
+	}
+
+	crt.Total = zero
+	for _, ct := range t.Categories {
+		crt.Total = crt.Total.Add(ct.Amount)
+		if ct.Surcharge != nil {
+			crt.Total = crt.Total.Add(*ct.Surcharge)
+		}
+	}
+	crt.Tax = nil
+	if len(t.Categories) > 0 {
+		rt := crt.Total
+		t.Retained = &rt
+		crt.Tax = t
+	}
+
+	return nil
+}
+
+// FromEndpoint returns the endpoint of the party sending the certificate,
+// which is always the customer who retained the taxes.
+func (crt *Certificate) FromEndpoint() *org.Endpoint {
+	if crt == nil {
+		return nil
+	}
+	return crt.Customer.FirstEndpoint()
+}
+
+// ToEndpoint returns the endpoint of the party receiving the certificate,
+// which is always the supplier.
+func (crt *Certificate) ToEndpoint() *org.Endpoint {
+	if crt == nil {
+		return nil
+	}
+	return crt.Supplier.FirstEndpoint()
+}
+
+// JSONSchemaExtend extends the schema with additional property details
+func (crt Certificate) JSONSchemaExtend(js *jsonschema.Schema) {
+	props := js.Properties
+	// Extend type list
+	if its, ok := props.Get("type"); ok {
+		its.OneOf = make([]*jsonschema.Schema, len(CertificateTypes))
+		for i, kd := range CertificateTypes {
+			its.OneOf[i] = &jsonschema.Schema{
+				Const:       kd.Key.String(),
+				Title:       kd.Name.String(),
+				Description: kd.Desc.String(),
+			}
+		}
+	}
+	// Recommendations
+	js.Extras = map[string]any{
+		schema.Recommended: []string{
+			"$regime",
+			"series",
+			"code",
+		},
+	}
+}
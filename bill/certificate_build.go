@@ -0,0 +1,155 @@
+package bill
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/tax"
+	"github.com/invopop/gobl/uuid"
+)
+
+// WithholdingCertificates builds a withholding certificate for each pair of
+// supplier and customer found in the provided invoices, including only the
+// retained taxes of the invoices whose tax date falls inside the period.
+// Credit notes will reduce the amounts retained.
+//
+// Invoices must have been calculated beforehand. The currency of the
+// first invoice for each supplier will be used for the certificate, and
+// subsequent invoices in other currencies must provide an exchange rate.
+// Each certificate will be calculated before being returned, using copies
+// of the invoice parties so that the source invoices are left untouched.
+func WithholdingCertificates(period cal.Period, invoices ...*Invoice) ([]*Certificate, error) {
+	certs := make([]*Certificate, 0)
+	index := make(map[string]*Certificate)
+	for _, inv := range invoices {
+		if inv == nil {
+			continue
+		}
+		if inv.Totals == nil {
+			return nil, fmt.Errorf("invoice %s: not calculated", invoiceLabel(inv))
+		}
+		if !periodIncludes(period, inv.GetTaxDate()) {
+			continue
+		}
+		rt := retainedTaxTotal(inv.GetTaxTotal())
+		if rt == nil {
+			continue
+		}
+		key := partyKey(inv.Supplier) + "|" + partyKey(inv.Customer)
+		crt, ok := index[key]
+		if !ok {
+			sup, err := cloneParty(inv.Supplier)
+			if err != nil {
+				return nil, fmt.Errorf("invoice %s: supplier: %w", invoiceLabel(inv), err)
+			}
+			cus, err := cloneParty(inv.Customer)
+			if err != nil {
+				return nil, fmt.Errorf("invoice %s: customer: %w", invoiceLabel(inv), err)
+			}
+			crt = &Certificate{
+				Regime:   inv.Regime,
+				Type:     CertificateTypeWithholding,
+				Period:   period,
+				Currency: inv.Currency,
+				Supplier: sup,
+				Customer: cus,
+				Lines:    make([]*CertificateLine, 0),
+			}
+			index[key] = crt
+			certs = append(certs, crt)
+		}
+		if inv.Currency != crt.Currency {
+			rate := currency.MatchExchangeRate(inv.ExchangeRates, inv.Currency, crt.Currency)
+			if rate == nil {
+				return nil, fmt.Errorf("invoice %s: no exchange rate from '%s' to '%s'", invoiceLabel(inv), inv.Currency, crt.Currency)
+			}
+			rt.Exchange(rate, inv.RegimeDef().GetRoundingRule())
+		}
+		crt.Lines = append(crt.Lines, &CertificateLine{
+			Document: &org.DocumentRef{
+				Identify:  uuid.Identify{UUID: inv.UUID},
+				Type:      inv.Type,
+				Series:    inv.Series,
+				Code:      inv.Code,
+				IssueDate: inv.IssueDate.Clone(),
+				Currency:  inv.Currency,
+			},
+			Tax: rt,
+		})
+	}
+	for _, crt := range certs {
+		if err := crt.Calculate(); err != nil {
+			return nil, err
+		}
+	}
+	return certs, nil
+}
+
+// retainedTaxTotal provides a new total containing only the retained
+// categories, or nil if there are none.
+func retainedTaxTotal(t *tax.Total) *tax.Total {
+	if t == nil || t.Retained == nil {
+		return nil
+	}
+	nt := t.Clone()
+	cats := make([]*tax.CategoryTotal, 0, len(nt.Categories))
+	for _, ct := range nt.Categories {
+		if ct.Retained {
+			cats = append(cats, ct)
+		}
+	}
+	if len(cats) == 0 {
+		return nil
+	}
+	nt.Categories = cats
+	nt.Sum = num.MakeAmount(0, nt.Sum.Exp())
+	return nt
+}
+
+func periodIncludes(p cal.Period, d cal.Date) bool {
+	if !p.Start.IsZero() && d.Before(p.Start.Date) {
+		return false
+	}
+	if !p.End.IsZero() && d.After(p.End.Date) {
+		return false
+	}
+	return true
+}
+
+// cloneParty makes a deep copy of the party by serializing and deserializing
+// its contents.
+func cloneParty(p *org.Party) (*org.Party, error) {
+	if p == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	np := new(org.Party)
+	if err := json.Unmarshal(data, np); err != nil {
+		return nil, err
+	}
+	return np, nil
+}
+
+func partyKey(p *org.Party) string {
+	if p == nil {
+		return ""
+	}
+	if p.TaxID != nil && p.TaxID.Code != "" {
+		return p.TaxID.Country.String() + p.TaxID.Code.String()
+	}
+	return p.Name
+}
+
+func invoiceLabel(inv *Invoice) string {
+	if inv.Series != "" {
+		return inv.Series.String() + "-" + inv.Code.String()
+	}
+	return inv.Code.String()
+}
@@ -0,0 +1,136 @@
+package bill_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withholdingInvoice(t *testing.T, code cbc.Code, date cal.Date, price int64) *bill.Invoice {
+	t.Helper()
+	inv := baseInvoice(t, &bill.Line{
+		Quantity: num.MakeAmount(1, 0),
+		Item: &org.Item{
+			Name:  "Professional services",
+			Price: num.NewAmount(price, 2),
+		},
+		Taxes: tax.Set{
+			{
+				Category: "VAT",
+				Rate:     "general",
+			},
+			{
+				Category: "IRPF",
+				Percent:  num.NewPercentage(15, 2),
+			},
+		},
+	})
+	inv.Code = code
+	inv.IssueDate = date
+	inv.Tax.PricesInclude = ""
+	require.NoError(t, inv.Calculate())
+	return inv
+}
+
+func TestWithholdingCertificates(t *testing.T) {
+	period := cal.Period{
+		Start: cal.MakeDate(2024, 1, 1),
+		End:   cal.MakeDate(2024, 12, 31),
+	}
+	t.Run("aggregates per supplier", func(t *testing.T) {
+		inv1 := withholdingInvoice(t, "001", cal.MakeDate(2024, 2, 1), 100000)
+		inv2 := withholdingInvoice(t, "002", cal.MakeDate(2024, 5, 1), 50000)
+		inv3 := withholdingInvoice(t, "003", cal.MakeDate(2024, 6, 1), 20000)
+		inv3.Type = bill.InvoiceTypeCreditNote
+		outside := withholdingInvoice(t, "004", cal.MakeDate(2025, 1, 1), 50000)
+		other := withholdingInvoice(t, "005", cal.MakeDate(2024, 3, 1), 10000)
+		other.Supplier = &org.Party{
+			Name: "Other Supplier",
+			TaxID: &tax.Identity{
+				Country: "ES",
+				Code:    "B85905495",
+			},
+		}
+		noRetained := baseInvoiceWithLines(t)
+		noRetained.IssueDate = cal.MakeDate(2024, 3, 1)
+		require.NoError(t, noRetained.Calculate())
+
+		certs, err := bill.WithholdingCertificates(period, inv1, nil, inv2, inv3, outside, other, noRetained)
+		require.NoError(t, err)
+		require.Len(t, certs, 2)
+
+		crt := certs[0]
+		assert.Equal(t, bill.CertificateTypeWithholding, crt.Type)
+		assert.Equal(t, "ES", crt.GetRegime().String())
+		assert.Equal(t, currency.EUR, crt.Currency)
+		assert.Equal(t, "Test Supplier", crt.Supplier.Name)
+		assert.Equal(t, "Test Customer", crt.Customer.Name)
+		require.Len(t, crt.Lines, 3)
+		assert.Equal(t, 3, crt.Lines[2].Index)
+		assert.Equal(t, "003", crt.Lines[2].Document.Code.String())
+		assert.Equal(t, bill.InvoiceTypeCreditNote, crt.Lines[2].Document.Type)
+		assert.Equal(t, currency.CodeEmpty, crt.Lines[2].Document.Currency)
+		assert.Equal(t, "-30.00", crt.Lines[2].Tax.Category("IRPF").Amount.String())
+		assert.Nil(t, crt.Lines[0].Tax.Category("VAT"))
+
+		irpf := crt.Tax.Category("IRPF")
+		require.NotNil(t, irpf)
+		assert.Equal(t, "1300.00", irpf.Rates[0].Base.String())
+		assert.Equal(t, "195.00", irpf.Amount.String())
+		assert.Equal(t, "195.00", crt.Total.String())
+		assert.Equal(t, "195.00", crt.Tax.Retained.String())
+		assert.Equal(t, "0.00", crt.Tax.Sum.String())
+
+		assert.Equal(t, "Other Supplier", certs[1].Supplier.Name)
+		assert.Equal(t, "15.00", certs[1].Total.String())
+	})
+	t.Run("leaves invoices untouched", func(t *testing.T) {
+		inv := withholdingInvoice(t, "001", cal.MakeDate(2024, 2, 1), 100000)
+		inv.Supplier.Name = " Test Supplier "
+		before, err := json.Marshal(inv)
+		require.NoError(t, err)
+
+		certs, err := bill.WithholdingCertificates(period, inv)
+		require.NoError(t, err)
+		require.Len(t, certs, 1)
+		assert.Equal(t, "Test Supplier", certs[0].Supplier.Name)
+		assert.NotSame(t, inv.Supplier, certs[0].Supplier)
+		assert.NotSame(t, inv.Customer, certs[0].Customer)
+
+		after, err := json.Marshal(inv)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(before), string(after))
+	})
+
+	t.Run("not calculated", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		_, err := bill.WithholdingCertificates(period, inv)
+		assert.ErrorContains(t, err, "invoice TEST-00123: not calculated")
+	})
+	t.Run("currency conversion", func(t *testing.T) {
+		inv1 := withholdingInvoice(t, "001", cal.MakeDate(2024, 2, 1), 100000)
+		inv2 := withholdingInvoice(t, "002", cal.MakeDate(2024, 2, 1), 100000)
+		inv2.Currency = currency.USD
+		_, err := bill.WithholdingCertificates(period, inv1, inv2)
+		assert.ErrorContains(t, err, "invoice TEST-002: no exchange rate from 'USD' to 'EUR'")
+
+		inv2.ExchangeRates = []*currency.ExchangeRate{
+			{From: currency.USD, To: currency.EUR, Amount: num.MakeAmount(5, 1)},
+		}
+		certs, err := bill.WithholdingCertificates(period, inv1, inv2)
+		require.NoError(t, err)
+		require.Len(t, certs, 1)
+		assert.Equal(t, currency.USD, certs[0].Lines[1].Document.Currency)
+		assert.Equal(t, "75.00", certs[0].Lines[1].Tax.Category("IRPF").Amount.String())
+		assert.Equal(t, "225.00", certs[0].Total.String())
+	})
+}
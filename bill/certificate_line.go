@@ -0,0 +1,53 @@
+package bill
+
+import (
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+	"github.com/invopop/gobl/uuid"
+)
+
+// CertificateLine defines the details of a document whose retained taxes are
+// included in a certificate.
+type CertificateLine struct {
+	uuid.Identify
+
+	// Line number within the parent document (automatically calculated)
+	Index int `json:"i" jsonschema:"title=Index" jsonschema_extras:"calculated=true"`
+
+	// Reference to the document with retained taxes.
+	Document *org.DocumentRef `json:"document" jsonschema:"title=Document"`
+
+	// Breakdown of the taxes retained from the document in the currency of the
+	// certificate. Amounts will be negative for credit notes.
+	Tax *tax.Total `json:"tax" jsonschema:"title=Tax"`
+
+	// Additional notes specific to this line.
+	Notes []*org.Note `json:"notes,omitempty" jsonschema:"title=Notes"`
+}
+
+func certificateLineRules() *rules.Set {
+	return rules.For(new(CertificateLine),
+		rules.Field("document",
+			rules.Assert("01", "certificate line document is required", is.Present),
+		),
+		rules.Field("tax",
+			rules.Assert("02", "certificate line tax is required", is.Present),
+		),
+	)
+}
+
+func (cl *CertificateLine) calculate(cur currency.Code) {
+	if cl.Document != nil && cl.Document.Currency == cur {
+		// no need to repeat the parent's currency
+		cl.Document.Currency = currency.CodeEmpty
+	}
+	if cl.Tax == nil {
+		return
+	}
+	// Amounts are copied from calculated documents, so only ensure they
+	// have the currency's precision.
+	cl.Tax.Round(cur.Def().Zero())
+}
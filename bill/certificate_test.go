@@ -0,0 +1,163 @@
+package bill_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) *bill.Certificate {
+	t.Helper()
+	return &bill.Certificate{
+		Code:      "CERT-001",
+		IssueDate: cal.MakeDate(2025, 1, 15),
+		Period: cal.Period{
+			Start: cal.MakeDate(2024, 1, 1),
+			End:   cal.MakeDate(2024, 12, 31),
+		},
+		Supplier: &org.Party{
+			Name: "Test Supplier",
+			TaxID: &tax.Identity{
+				Country: "ES",
+				Code:    "B98602642",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Test Customer",
+			TaxID: &tax.Identity{
+				Country: "ES",
+				Code:    "54387763P",
+			},
+		},
+		Lines: []*bill.CertificateLine{
+			{
+				Document: &org.DocumentRef{
+					Code:      "001",
+					IssueDate: cal.NewDate(2024, 2, 1),
+				},
+				Tax: &tax.Total{
+					Categories: []*tax.CategoryTotal{
+						{
+							Code:     "IRPF",
+							Retained: true,
+							Rates: []*tax.RateTotal{
+								{
+									Base:    num.MakeAmount(100000, 2),
+									Percent: num.NewPercentage(15, 2),
+									Amount:  num.MakeAmount(15000, 2),
+								},
+							},
+							Amount: num.MakeAmount(15000, 2),
+						},
+					},
+					Sum:      num.MakeAmount(0, 2),
+					Retained: num.NewAmount(15000, 2),
+				},
+			},
+		},
+	}
+}
+
+func TestCertificateCalculate(t *testing.T) {
+	crt := testCertificate(t)
+	require.NoError(t, crt.Calculate())
+	assert.Equal(t, "ES", crt.GetRegime().String())
+	assert.Equal(t, bill.CertificateTypeWithholding, crt.Type)
+	assert.Equal(t, "EUR", crt.Currency.String())
+	assert.Equal(t, 1, crt.Lines[0].Index)
+	assert.Equal(t, "150.00", crt.Total.String())
+	assert.Equal(t, "150.00", crt.Tax.Retained.String())
+	assert.True(t, crt.CanSign())
+
+	t.Run("without currency", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.Supplier.TaxID = nil
+		assert.ErrorContains(t, crt.Calculate(), "currency: missing or invalid")
+	})
+	t.Run("unknown currency", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.Currency = "ZZZ"
+		require.NoError(t, crt.Calculate())
+		assert.Equal(t, "EUR", crt.Currency.String(), "regime currency")
+
+		crt = testCertificate(t)
+		crt.Currency = "ZZZ"
+		crt.Supplier.TaxID = nil
+		assert.ErrorContains(t, crt.Calculate(), "currency: missing or invalid")
+	})
+	t.Run("sets issue date", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.IssueDate = cal.Date{}
+		require.NoError(t, crt.Calculate())
+		assert.False(t, crt.IssueDate.IsZero())
+	})
+}
+
+func TestCertificateValidation(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		crt := testCertificate(t)
+		require.NoError(t, crt.Calculate())
+		assert.NoError(t, rules.Validate(crt))
+	})
+	t.Run("missing parties and lines", func(t *testing.T) {
+		crt := testCertificate(t)
+		require.NoError(t, crt.Calculate())
+		crt.Customer = nil
+		crt.Lines = nil
+		err := rules.Validate(crt)
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-07]")
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-08]")
+	})
+	t.Run("missing tax IDs", func(t *testing.T) {
+		crt := testCertificate(t)
+		require.NoError(t, crt.Calculate())
+		crt.Supplier.TaxID = nil
+		crt.Customer.TaxID = &tax.Identity{Country: "ES"}
+		err := rules.Validate(crt)
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-10] ($.supplier.tax_id) certificate supplier tax ID is required")
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-13] ($.customer.tax_id.code) certificate customer tax ID code is required")
+
+		crt = testCertificate(t)
+		require.NoError(t, crt.Calculate())
+		crt.Supplier.TaxID.Code = ""
+		crt.Customer.TaxID = nil
+		err = rules.Validate(crt)
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-11]")
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-12]")
+	})
+	t.Run("non-retained categories", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.Lines[0].Tax.Categories[0].Code = tax.CategoryVAT
+		require.NoError(t, crt.Calculate())
+		err := rules.Validate(crt)
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATE-09] certificate taxes must be retained categories in the regime")
+	})
+	t.Run("missing line data", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.Lines[0].Document = nil
+		crt.Lines[0].Tax = nil
+		require.NoError(t, crt.Calculate())
+		err := rules.Validate(crt)
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATELINE-01]")
+		assert.ErrorContains(t, err, "[GOBL-BILL-CERTIFICATELINE-02]")
+	})
+}
+
+func TestCertificateEndpoints(t *testing.T) {
+	crt := testCertificate(t)
+	crt.Supplier.Endpoints = []*org.Endpoint{{URI: "gobl:supplier.example"}}
+	crt.Customer.Endpoints = []*org.Endpoint{{URI: "gobl:customer.example"}}
+	assert.Equal(t, "gobl:customer.example", crt.FromEndpoint().URI.String())
+	assert.Equal(t, "gobl:supplier.example", crt.ToEndpoint().URI.String())
+
+	var nilCrt *bill.Certificate
+	assert.Nil(t, nilCrt.FromEndpoint())
+	assert.Nil(t, nilCrt.ToEndpoint())
+}
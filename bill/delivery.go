@@ -114,16 +114,16 @@ type Delivery struct {
 	// be left empty initially, but is **required** to **sign** the document.
 	Code cbc.Code `json:"code,omitempty" jsonschema:"title=Code"`
 	// When the delivery document is to be issued.
-	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true"`
+	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true,defaulted=true"`
 	// IssueTime is an optional field that may be useful to indicate the time of day when
 	// the delivery was issued. Some regions and formats may require this field to be set.
 	// An empty string will be automatically updated to reflect the current time, otherwise
 	// the field can be left with a nil value.
-	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true"`
+	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true,defaulted=true"`
 	// When the taxes of this delivery become accountable, if none set, the issue date is used.
 	ValueDate *cal.Date `json:"value_date,omitempty" jsonschema:"title=Value Date"`
 	// Currency for all delivery totals.
-	Currency currency.Code `json:"currency,omitempty" jsonschema:"title=Currency" jsonschema_extras:"calculated=true"`
+	Currency currency.Code `json:"currency,omitempty" jsonschema:"title=Currency" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Exchange rates to be used when converting the invoices monetary values into other currencies.
 	ExchangeRates []*currency.ExchangeRate `json:"exchange_rates,omitempty" jsonschema:"title=Exchange Rates"`
 
@@ -219,15 +219,36 @@ func deliveryRules() *rules.Set {
 	)
 }
 
+// RulesContext adds the delivery's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (dlv *Delivery) RulesContext() rules.WithContext {
+	return issueDateContext(dlv.IssueDate)
+}
+
 // Calculate performs all the normalizations and calculations required for the delivery
 // totals and taxes. If the original delivery only includes partial calculations, this
 // will figure out what's missing.
 func (dlv *Delivery) Calculate() error {
+	return dlv.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the delivery's inputs: the regime determined from the supplier,
+// normalizations, and default values like the issue date and currency.
+// Calculated amounts and totals are not recorded.
+func (dlv *Delivery) CalculateWithRecorder(rec *norm.Recorder) error {
+	return dlv.calculate(rec)
+}
+
+func (dlv *Delivery) calculate(rec *norm.Recorder) error {
 	// Try to set Regime if not already prepared from the supplier's tax ID
 	if dlv.Regime.IsEmpty() {
-		dlv.SetRegime(partyTaxCountry(dlv.Supplier))
+		_ = rec.Record(dlv, originRegime, func() error {
+			dlv.SetRegime(partyTaxCountry(dlv.Supplier))
+			return nil
+		})
 	}
-	norm.Normalize(dlv)
+	norm.Normalize(dlv, norm.WithRecorder(rec))
 
 	supportedTags := dlv.supportedTags()
 	for _, tag := range dlv.Tags.List {
@@ -236,6 +257,13 @@ func (dlv *Delivery) Calculate() error {
 		}
 	}
 
+	err := rec.Record(dlv, originDefaults, func() error {
+		return calculateDefaults(dlv)
+	})
+	if err != nil {
+		return err
+	}
+
 	return calculate(dlv)
 }
 
@@ -315,6 +343,15 @@ func (dlv *Delivery) getIssueTime() *cal.Time {
 func (dlv *Delivery) getValueDate() *cal.Date {
 	return dlv.ValueDate
 }
+func (dlv *Delivery) getOperationDate() *cal.Date {
+	return nil // no operation date for deliveries
+}
+func (dlv *Delivery) getDeliveryDate() *cal.Date {
+	if dlv.ReceiveDate != nil {
+		return dlv.ReceiveDate
+	}
+	return dlv.DespatchDate
+}
 func (dlv *Delivery) getTax() *Tax {
 	return dlv.Tax
 }
@@ -21,3 +21,18 @@ type DeliveryDetails struct {
 	// Additional custom data.
 	Meta *cbc.Meta `json:"meta,omitempty" jsonschema:"title=Meta"`
 }
+
+// effectiveDate provides the date on which delivery is expected to be
+// completed, using the end of the period if no specific date is set.
+func (dd *DeliveryDetails) effectiveDate() *cal.Date {
+	if dd == nil {
+		return nil
+	}
+	if dd.Date != nil && !dd.Date.IsZero() {
+		return dd.Date
+	}
+	if dd.Period != nil && !dd.Period.End.IsZero() {
+		return &dd.Period.End
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/currency"
 	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/num"
 	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/rules/is"
@@ -33,7 +34,7 @@ type Invoice struct {
 	uuid.Identify
 
 	// Type of invoice document. May be restricted by local tax regime requirements.
-	Type cbc.Key `json:"type" jsonschema:"title=Type" jsonschema_extras:"calculated=true"`
+	Type cbc.Key `json:"type" jsonschema:"title=Type" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Series is used to identify groups of invoices by date, business area, project,
 	// type of document, customer type, a combination of any or other company specific data.
 	// If the output format does not support the series as a separate field, it will be
@@ -45,18 +46,19 @@ type Invoice struct {
 	// Issue date for when the invoice was created and issued. Todays date is used if
 	// none is set. There are often legal restrictions on how far back or in the future an
 	// invoice can be issued.
-	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true"`
+	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true,defaulted=true"`
 	// IssueTime is an optional field that may be useful to indicate the time of day when
 	// the invoice was issued. Some regions and formats may require this field to be set.
 	// An empty string will be automatically updated to reflect the current time, otherwise
 	// the field can be left with a nil value.
-	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true"`
+	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Date when the operation defined by the invoice became effective.
 	OperationDate *cal.Date `json:"op_date,omitempty" jsonschema:"title=Operation Date"`
-	// When the taxes of this invoice become accountable, if none set, the issue date is used.
+	// When the taxes of this invoice become accountable, if none set, the date will be
+	// determined from the tax point, or the issue date will be used.
 	ValueDate *cal.Date `json:"value_date,omitempty" jsonschema:"title=Value Date"`
 	// Currency for all invoice amounts and totals, unless explicitly stated otherwise.
-	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true"`
+	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Exchange rates to be used when converting the invoices monetary values into other currencies.
 	ExchangeRates []*currency.ExchangeRate `json:"exchange_rates,omitempty" jsonschema:"title=Exchange Rates"`
 
@@ -166,9 +168,57 @@ func invoiceRules() *rules.Set {
 				rules.Assert("10", "invoice lines are required without discounts or charges", is.Present),
 			),
 		),
+		// Under the margin scheme, taxes are included in the line prices and
+		// calculated on the margin, so they must never be itemised. Document
+		// level discounts and charges are not supported as their taxes
+		// would be itemised, nor are retained taxes, which have no base.
+		rules.When(is.Func("margin scheme", invoiceIsMarginScheme),
+			rules.Field("lines",
+				rules.Each(
+					rules.Field("purchase_cost",
+						rules.Assert("12", "invoice line purchase cost is required with the margin scheme", is.Present),
+					),
+					rules.Field("taxes",
+						rules.Each(
+							rules.Assert("17", "invoice line taxes must not be retained with the margin scheme", tax.ComboNotRetained()),
+						),
+					),
+				),
+			),
+			rules.Field("totals",
+				rules.Field("tax",
+					rules.Assert("13", "invoice tax must not be itemised with the margin scheme", num.Equals(num.AmountZero)),
+				),
+			),
+			rules.Field("discounts",
+				rules.Assert("15", "invoice discounts are not allowed with the margin scheme", is.Empty),
+			),
+			rules.Field("charges",
+				rules.Assert("16", "invoice charges are not allowed with the margin scheme", is.Empty),
+			),
+		),
+		rules.When(is.Func("not margin scheme", invoiceIsNotMarginScheme),
+			rules.Field("lines",
+				rules.Each(
+					rules.Field("purchase_cost",
+						rules.Assert("14", "invoice line purchase cost requires the margin scheme", is.Empty),
+					),
+				),
+			),
+		),
 	)
 }
 
+func invoiceIsMarginScheme(val any) bool {
+	inv, ok := val.(*Invoice)
+	return ok && inv != nil && inv.HasTags(tax.TagMarginScheme)
+}
+
+func invoiceIsNotMarginScheme(val any) bool {
+	inv, ok := val.(*Invoice)
+	return ok && inv != nil && !inv.HasTags(tax.TagMarginScheme)
+}
+
 func invoiceHasTaxPoint(val any) bool {
 	var inv *Invoice
 	switch v := val.(type) {
@@ -229,6 +279,10 @@ func (inv *Invoice) Invert() error {
 		for _, c := range row.Charges {
 			c.Amount = c.Amount.Invert()
 		}
+		if row.PurchaseCost != nil {
+			pc := row.PurchaseCost.Invert()
+			row.PurchaseCost = &pc
+		}
 	}
 	for _, row := range inv.Charges {
 		row.Amount = row.Amount.Invert()
@@ -266,16 +320,38 @@ func (inv *Invoice) Empty() {
 	inv.Payment.ResetAdvances()
 }
 
+// RulesContext adds the invoice's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (inv *Invoice) RulesContext() rules.WithContext {
+	return issueDateContext(inv.IssueDate)
+}
+
 // Calculate performs all the normalizations and calculations required for the invoice
 // totals and taxes. If the original invoice only includes partial calculations, this
 // will figure out what's missing.
 func (inv *Invoice) Calculate() error {
+	return inv.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the invoice's inputs: the regime determined from the supplier,
+// normalizations, default values like the issue date and currency, and the
+// notes and extensions added by scenarios. Calculated amounts and totals are
+// not recorded.
+func (inv *Invoice) CalculateWithRecorder(rec *norm.Recorder) error {
+	return inv.calculate(rec)
+}
+
+func (inv *Invoice) calculate(rec *norm.Recorder) error {
 	// Try to set Regime if not already prepared from the supplier's tax ID
 	if inv.Regime.IsEmpty() {
-		inv.SetRegime(partyTaxCountry(inv.Supplier))
+		_ = rec.Record(inv, originRegime, func() error {
+			inv.SetRegime(partyTaxCountry(inv.Supplier))
+			return nil
+		})
 	}
 
-	norm.Normalize(inv)
+	norm.Normalize(inv, norm.WithRecorder(rec))
 
 	for _, tag := range inv.Tags.List {
 		if !tag.In(inv.supportedTags()...) {
@@ -283,15 +359,18 @@ func (inv *Invoice) Calculate() error {
 		}
 	}
 
-	if err := calculate(inv); err != nil {
+	err := rec.Record(inv, originDefaults, func() error {
+		return calculateDefaults(inv)
+	})
+	if err != nil {
 		return err
 	}
 
-	if err := inv.prepareScenarios(); err != nil {
+	if err := calculate(inv); err != nil {
 		return err
 	}
 
-	return nil
+	return rec.Record(inv, originScenarios, inv.prepareScenarios)
 }
 
 func (inv *Invoice) supportedTags() []cbc.Key {
@@ -318,6 +397,28 @@ func (inv *Invoice) RemoveIncludedTaxes() error {
 	return removeIncludedTaxes(inv)
 }
 
+// GetTaxDate provides the date on which the invoice's taxes become accountable,
+// determined from the value date, tax point, or issue date.
+func (inv *Invoice) GetTaxDate() cal.Date {
+	return taxDate(inv)
+}
+
+// GetTaxTotal provides the invoice's calculated tax totals for reporting
+// purposes. Credit notes will have their amounts negated, and proforma
+// invoices, which carry no tax liability, will return nil.
+func (inv *Invoice) GetTaxTotal() *tax.Total {
+	if inv.Totals == nil || inv.Totals.Taxes == nil {
+		return nil
+	}
+	switch inv.Type {
+	case InvoiceTypeProforma:
+		return nil
+	case InvoiceTypeCreditNote:
+		return inv.Totals.Taxes.Negate()
+	}
+	return inv.Totals.Taxes
+}
+
 /** Calculation Interface Methods **/
 
 // GetCurrency provides the documents current currency code.
@@ -339,6 +440,12 @@ func (inv *Invoice) getIssueTime() *cal.Time {
 func (inv *Invoice) getValueDate() *cal.Date {
 	return inv.ValueDate
 }
+func (inv *Invoice) getOperationDate() *cal.Date {
+	return inv.OperationDate
+}
+func (inv *Invoice) getDeliveryDate() *cal.Date {
+	return inv.Delivery.effectiveDate()
+}
 func (inv *Invoice) getTax() *Tax {
 	return inv.Tax
 }
@@ -2,6 +2,7 @@ package bill
 
 import (
 	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
 	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/tax"
 )
@@ -28,6 +29,46 @@ func InvoiceScenarios() *tax.ScenarioSet {
 	return invoiceScenarios
 }
 
+// ReverseChargeScenarios provides the VAT reverse charge scenarios used by
+// regimes with a domestic reverse charge mechanism. The first note is added
+// to all reverse charge invoices and is replaced by the domestic note when
+// the customer is registered for VAT in the given country.
+func ReverseChargeScenarios(country l10n.TaxCountryCode, text, domestic string) []*tax.Scenario {
+	return []*tax.Scenario{
+		{
+			Tags:       []cbc.Key{tax.TagReverseCharge},
+			Categories: []cbc.Code{tax.CategoryVAT},
+			Note: &tax.Note{
+				Category: tax.CategoryVAT,
+				Key:      tax.KeyReverseCharge,
+				Text:     text,
+			},
+		},
+		{
+			Tags:       []cbc.Key{tax.TagReverseCharge},
+			Categories: []cbc.Code{tax.CategoryVAT},
+			Filter:     InvoiceCustomerIn(country),
+			Note: &tax.Note{
+				Category: tax.CategoryVAT,
+				Key:      tax.KeyReverseCharge,
+				Text:     domestic,
+			},
+		},
+	}
+}
+
+// InvoiceCustomerIn provides a scenario filter that matches invoices whose
+// customer has a tax ID from one of the given countries.
+func InvoiceCustomerIn(countries ...l10n.TaxCountryCode) func(doc any) bool {
+	return func(doc any) bool {
+		inv, ok := doc.(*Invoice)
+		if !ok || inv.Customer == nil || inv.Customer.TaxID == nil {
+			return false
+		}
+		return inv.Customer.TaxID.Country.In(countries...)
+	}
+}
+
 // GetType provides the invoice type as part of the tax.ScenarioDocument interface.
 func (inv *Invoice) GetType() cbc.Key {
 	return inv.Type
@@ -6,6 +6,7 @@ import (
 	"github.com/invopop/gobl/addons/it/sdi"
 	"github.com/invopop/gobl/bill"
 	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/tax"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -141,3 +142,30 @@ func TestInvoiceScenarios(t *testing.T) {
 		assert.Len(t, list.List, 1)
 	})
 }
+
+func TestReverseChargeScenarios(t *testing.T) {
+	list := bill.ReverseChargeScenarios("CZ", "Reverse charge", "Domestic reverse charge")
+	require.Len(t, list, 2)
+	assert.Equal(t, "Reverse charge", list[0].Note.Text)
+	assert.Nil(t, list[0].Filter)
+	assert.Equal(t, "Domestic reverse charge", list[1].Note.Text)
+
+	inv := baseInvoice(t)
+	assert.False(t, list[1].Filter(inv), "no customer tax ID")
+	inv.Customer.TaxID = &tax.Identity{Country: "DE", Code: "111111125"}
+	assert.False(t, list[1].Filter(inv))
+	inv.Customer.TaxID = &tax.Identity{Country: "CZ", Code: "27082440"}
+	assert.True(t, list[1].Filter(inv))
+	assert.False(t, list[1].Filter(&bill.Order{}), "not an invoice")
+}
+
+func TestInvoiceCustomerIn(t *testing.T) {
+	filter := bill.InvoiceCustomerIn("HU", "RO")
+	inv := baseInvoice(t)
+	inv.Customer = nil
+	assert.False(t, filter(inv))
+	inv.Customer = &org.Party{Name: "Customer", TaxID: &tax.Identity{Country: "RO", Code: "18547290"}}
+	assert.True(t, filter(inv))
+	inv.Customer.TaxID.Country = "SK"
+	assert.False(t, filter(inv))
+}
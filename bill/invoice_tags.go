@@ -116,6 +116,23 @@ var defaultInvoiceTags = &tax.TagSet{
 				`),
 			},
 		},
+		// Margin scheme used for second-hand goods, works of art, antiques,
+		// and travel agencies, where tax is only applied to the margin.
+		{
+			Key: tax.TagMarginScheme,
+			Name: i18n.String{
+				i18n.EN: "Margin Scheme",
+			},
+			Desc: i18n.String{
+				i18n.EN: here.Doc(`
+					Used for the special schemes for second-hand goods, works of art, collectors' items,
+					antiques, and travel agencies, where tax is calculated only on the supplier's margin:
+					the difference between the line total and its purchase cost. Taxes are included in
+					the prices and are not itemised to the customer, with the non-disclosed amount
+					available in the totals for reporting.
+				`),
+			},
+		},
 		{
 			Key: tax.TagFactoring,
 			Name: i18n.String{
@@ -12,6 +12,7 @@ import (
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/currency"
 	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/num"
 	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/pay"
@@ -22,6 +23,33 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestInvoiceRulesContext(t *testing.T) {
+	inv := &bill.Invoice{IssueDate: cal.MakeDate(2025, 6, 1)}
+	rc := new(rules.Context)
+	inv.RulesContext()(rc)
+	assert.Equal(t, cal.MakeDate(2025, 6, 1).Date, rc.Value(rules.ContextKeyDate))
+
+	inv = new(bill.Invoice)
+	rc = new(rules.Context)
+	inv.RulesContext()(rc)
+	assert.Nil(t, rc.Value(rules.ContextKeyDate))
+}
+
+func TestInvoiceNormalizeDryRun(t *testing.T) {
+	inv := baseInvoiceWithLines(t)
+	inv.SetRegime("ES")
+	inv.Supplier.TaxID.Code = "b-98602642"
+	changes, err := norm.DryRun(inv)
+	require.NoError(t, err)
+	assert.Equal(t, cbc.Code("b-98602642"), inv.Supplier.TaxID.Code)
+	require.NotEmpty(t, changes)
+	c := changes[0]
+	assert.Equal(t, "/supplier/tax_id/code", c.Path)
+	assert.Equal(t, "b-98602642", c.Old)
+	assert.Equal(t, "B98602642", c.New)
+	assert.Equal(t, "github.com/invopop/gobl/regimes/es.normalizeTaxIdentity", c.Origin)
+}
+
 func TestInvoiceRegimeCurrency(t *testing.T) {
 	lines := []*bill.Line{
 		{
@@ -1551,3 +1579,151 @@ func TestInvoiceJSONSchemaExtend(t *testing.T) {
 	})
 
 }
+
+func TestInvoiceTaxReporting(t *testing.T) {
+	t.Run("tax date", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		assert.Equal(t, "2022-06-13", inv.GetTaxDate().String())
+		inv.Tax.Point = tax.PointDelivery
+		inv.Delivery = &bill.DeliveryDetails{Date: cal.NewDate(2022, 5, 30)}
+		assert.Equal(t, "2022-05-30", inv.GetTaxDate().String())
+	})
+	t.Run("tax totals", func(t *testing.T) {
+		inv := baseInvoiceWithLines(t)
+		assert.Nil(t, inv.GetTaxTotal())
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "173.55", inv.GetTaxTotal().Sum.String())
+
+		inv.Type = bill.InvoiceTypeCreditNote
+		assert.Equal(t, "-173.55", inv.GetTaxTotal().Sum.String())
+		assert.Equal(t, "173.55", inv.Totals.Taxes.Sum.String())
+
+		inv.Type = bill.InvoiceTypeProforma
+		assert.Nil(t, inv.GetTaxTotal())
+	})
+	t.Run("report", func(t *testing.T) {
+		inv1 := baseInvoiceWithLines(t)
+		require.NoError(t, inv1.Calculate())
+		inv2 := baseInvoiceWithLines(t)
+		inv2.IssueDate = cal.MakeDate(2022, 6, 20)
+		inv2.Type = bill.InvoiceTypeCreditNote
+		inv2.Lines[0].Quantity = num.MakeAmount(2, 0)
+		require.NoError(t, inv2.Calculate())
+		inv3 := baseInvoiceWithLines(t)
+		inv3.IssueDate = cal.MakeDate(2022, 7, 1)
+		require.NoError(t, inv3.Calculate())
+
+		r, err := tax.NewReport(currency.EUR, tax.ReportIntervalMonth)
+		require.NoError(t, err)
+		require.NoError(t, r.Add(inv1, inv2, inv3))
+		require.Len(t, r.Periods, 2)
+		p := r.Periods[0]
+		assert.Equal(t, 2, p.Documents)
+		require.Len(t, p.Rows, 1)
+		assert.Equal(t, "661.16", p.Rows[0].Base.String())
+		assert.Equal(t, "138.84", p.Sum.String())
+		assert.Equal(t, "173.55", r.Periods[1].Sum.String())
+	})
+}
+
+func TestInvoiceCalculateWithRecorder(t *testing.T) {
+	inv := baseInvoice(t, &bill.Line{
+		Quantity: num.MakeAmount(1, 0),
+		Item: &org.Item{
+			Name:  " Test Item ",
+			Price: num.NewAmount(10000, 2),
+		},
+		Taxes: tax.Set{
+			{
+				Category: "VAT",
+				Rate:     "general",
+			},
+		},
+	})
+	inv.Supplier.TaxID.Code = "b98-602-642"
+	inv.IssueDate = cal.Date{}
+	inv.SetTags(tax.TagSelfBilled)
+
+	rec := norm.NewRecorder()
+	require.NoError(t, inv.CalculateWithRecorder(rec))
+	assert.Equal(t, "B98602642", inv.Supplier.TaxID.Code.String())
+	assert.Equal(t, "Test Item", inv.Lines[0].Item.Name)
+
+	changes := make(map[string]*norm.Change)
+	for _, c := range rec.Changes() {
+		changes[c.Path] = c
+	}
+	c := changes["/supplier/tax_id/code"]
+	require.NotNil(t, c)
+	assert.Equal(t, "b98-602-642", c.Old)
+	assert.Equal(t, "B98602642", c.New)
+	assert.Equal(t, "github.com/invopop/gobl/regimes/es.normalizeTaxIdentity", c.Origin)
+	c = changes["/lines/0/item/name"]
+	require.NotNil(t, c)
+	assert.Equal(t, " Test Item ", c.Old)
+	assert.Equal(t, "Test Item", c.New)
+	c = changes["/type"]
+	require.NotNil(t, c)
+	assert.Equal(t, "", c.Old)
+	assert.Equal(t, "standard", c.New)
+
+	c = changes["/$regime"]
+	require.NotNil(t, c)
+	assert.Equal(t, "ES", c.New)
+	assert.Equal(t, "bill: regime from supplier", c.Origin)
+	c = changes["/currency"]
+	require.NotNil(t, c)
+	assert.Equal(t, "EUR", c.New)
+	assert.Equal(t, "bill: default values", c.Origin)
+	c = changes["/issue_date"]
+	require.NotNil(t, c)
+	assert.Equal(t, inv.IssueDate.String(), c.New)
+	assert.Equal(t, "bill: default values", c.Origin)
+	c = changes["/tax/notes"]
+	require.NotNil(t, c)
+	assert.Equal(t, []any{map[string]any{"key": "self-billed", "text": "Facturación por el destinatario."}}, c.New)
+	assert.Equal(t, "bill: scenarios", c.Origin)
+
+	assert.NotContains(t, changes, "/totals/sum", "calculated totals are not recorded")
+	assert.NotContains(t, changes, "/lines/0/sum", "calculated line sums are not recorded")
+}
+
+func TestCalculateWithRecorder(t *testing.T) {
+	supplier := &org.Party{
+		Name:  "Test Supplier",
+		TaxID: &tax.Identity{Country: "ES", Code: "B98602642"},
+	}
+	docs := map[string]interface {
+		CalculateWithRecorder(*norm.Recorder) error
+	}{
+		"order":       &bill.Order{Code: "123", Supplier: supplier},
+		"delivery":    &bill.Delivery{Code: "123", Supplier: supplier},
+		"payment":     &bill.Payment{Code: "123", Supplier: supplier},
+		"certificate": &bill.Certificate{Code: "123", Supplier: supplier},
+	}
+	for name, doc := range docs {
+		t.Run(name, func(t *testing.T) {
+			rec := norm.NewRecorder()
+			require.NoError(t, doc.CalculateWithRecorder(rec))
+			changes := make(map[string]*norm.Change)
+			for _, c := range rec.Changes() {
+				changes[c.Path] = c
+			}
+			assert.Equal(t, "bill: regime from supplier", changes["/$regime"].Origin)
+			assert.Equal(t, "bill: default values", changes["/issue_date"].Origin)
+			assert.Equal(t, "EUR", changes["/currency"].New)
+		})
+	}
+
+	t.Run("status", func(t *testing.T) {
+		st := &bill.Status{Code: "123", Supplier: supplier}
+		rec := norm.NewRecorder()
+		require.NoError(t, st.CalculateWithRecorder(rec))
+		changes := make(map[string]*norm.Change)
+		for _, c := range rec.Changes() {
+			changes[c.Path] = c
+		}
+		assert.Equal(t, "bill: regime from supplier", changes["/$regime"].Origin)
+		assert.Equal(t, "bill: default values", changes["/issue_date"].Origin)
+	})
+}
@@ -44,6 +44,11 @@ type Line struct {
 	Taxes tax.Set `json:"taxes,omitempty" jsonschema:"title=Taxes"`
 	// Total line amount after applying discounts to the sum (calculated).
 	Total *num.Amount `json:"total,omitempty" jsonschema:"title=Total"  jsonschema_extras:"calculated=true"`
+	// Total amount paid by the supplier to acquire the goods or services sold in
+	// the line, in the document's currency. Required for documents using the margin
+	// scheme, where taxes are calculated only on the difference between the line
+	// total and the purchase cost.
+	PurchaseCost *num.Amount `json:"purchase_cost,omitempty" jsonschema:"title=Purchase Cost"`
 
 	// List of substituted lines. Useful for deliveries or corrective documents in order
 	// to indicate to the recipient which of the requested lines are being replaced.
@@ -0,0 +1,93 @@
+package bill
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+// marginLine is used to calculate taxes on lines under the margin scheme,
+// where only the difference between the line total and the purchase cost
+// is taxed, with the tax already included.
+type marginLine struct {
+	margin num.Amount
+	taxes  tax.Set
+}
+
+// GetTaxes implements the tax.TaxableLine interface.
+func (ml *marginLine) GetTaxes() tax.Set {
+	return ml.taxes
+}
+
+// GetTotal implements the tax.TaxableLine interface.
+func (ml *marginLine) GetTotal() num.Amount {
+	return ml.margin
+}
+
+// isMarginLine returns true if the line's taxes should be calculated
+// on the margin as opposed to the line total.
+func isMarginLine(doc billable, l *Line) bool {
+	return l != nil && l.PurchaseCost != nil && doc.HasTags(tax.TagMarginScheme)
+}
+
+// prepareMarginLines builds the list of taxable lines with the margin of each
+// line under the margin scheme. Losses are not deductible from the margins
+// of other lines, so negative margins are replaced with zero.
+func prepareMarginLines(doc billable, cur currency.Code) []tax.TaxableLine {
+	cd := cur.Def()
+	zero := cd.Zero()
+	mls := make([]tax.TaxableLine, 0)
+	for _, l := range doc.getLines() {
+		if !isMarginLine(doc, l) || l.Total == nil {
+			continue
+		}
+		cost := cd.RescaleUp(*l.PurchaseCost)
+		l.PurchaseCost = &cost
+		margin := l.Total.Subtract(cost)
+		if !margin.IsZero() && margin.IsNegative() != l.Total.IsNegative() {
+			margin = zero
+		}
+		mls = append(mls, &marginLine{
+			margin: margin,
+			taxes:  l.Taxes,
+		})
+	}
+	return mls
+}
+
+// calculateMarginTotals determines the margin and non-disclosed tax amounts
+// from the margin lines, using the included category or the first
+// non-retained category found in the lines.
+func calculateMarginTotals(t *Totals, tc *tax.TotalCalculator, r *tax.RegimeDef) error {
+	if tc.Includes.IsEmpty() {
+		tc.Includes = marginIncludedCategory(tc.Lines, r)
+	}
+	mt := new(tax.Total)
+	if err := tc.Calculate(mt); err != nil {
+		return err
+	}
+	margin := tc.Currency.Def().Zero()
+	for _, ml := range tc.Lines {
+		margin = margin.MatchPrecision(ml.GetTotal())
+		margin = margin.Add(ml.GetTotal())
+	}
+	t.Margin = &margin
+	t.MarginTax = &mt.Sum
+	return nil
+}
+
+func marginIncludedCategory(lines []tax.TaxableLine, r *tax.RegimeDef) cbc.Code {
+	for _, ml := range lines {
+		for _, c := range ml.GetTaxes() {
+			if c == nil {
+				continue
+			}
+			if cd := r.CategoryDef(c.Category); cd != nil && cd.Retained {
+				continue
+			}
+			return c.Category
+		}
+	}
+	return tax.CategoryVAT
+}
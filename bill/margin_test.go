@@ -0,0 +1,154 @@
+package bill_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func marginInvoice(t *testing.T) *bill.Invoice {
+	t.Helper()
+	inv := baseInvoice(t,
+		&bill.Line{
+			Quantity: num.MakeAmount(1, 0),
+			Item: &org.Item{
+				Name:  "Second-hand car",
+				Price: num.NewAmount(121000, 2),
+			},
+			PurchaseCost: num.NewAmount(80000, 2),
+			Taxes: tax.Set{
+				{Category: tax.CategoryVAT, Rate: "general"},
+			},
+		},
+		&bill.Line{
+			Quantity: num.MakeAmount(2, 0),
+			Item: &org.Item{
+				Name:  "Antique chair",
+				Price: num.NewAmount(25000, 2),
+			},
+			PurchaseCost: num.NewAmount(60000, 2),
+			Taxes: tax.Set{
+				{Category: tax.CategoryVAT, Rate: "general"},
+			},
+		},
+	)
+	inv.Tax = nil
+	inv.SetTags(tax.TagMarginScheme)
+	return inv
+}
+
+func TestInvoiceMarginScheme(t *testing.T) {
+	t.Run("calculate", func(t *testing.T) {
+		inv := marginInvoice(t)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "1710.00", inv.Totals.Sum.String())
+		assert.Equal(t, "1710.00", inv.Totals.Total.String())
+		assert.Nil(t, inv.Totals.Taxes)
+		assert.Equal(t, "0.00", inv.Totals.Tax.String())
+		assert.Equal(t, "1710.00", inv.Totals.TotalWithTax.String())
+		assert.Equal(t, "1710.00", inv.Totals.Payable.String())
+		require.NotNil(t, inv.Totals.Margin)
+		assert.Equal(t, "410.00", inv.Totals.Margin.String(), "negative margins are ignored")
+		require.NotNil(t, inv.Totals.MarginTax)
+		assert.Equal(t, "71.16", inv.Totals.MarginTax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("with prices including tax", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.Tax = &bill.Tax{PricesInclude: tax.CategoryVAT}
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "1710.00", inv.Totals.Total.String())
+		assert.Nil(t, inv.Totals.TaxIncluded)
+		assert.Equal(t, "71.16", inv.Totals.MarginTax.String())
+	})
+	t.Run("with regular lines", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.Lines = append(inv.Lines, &bill.Line{
+			Quantity: num.MakeAmount(1, 0),
+			Item: &org.Item{
+				Name:  "Transport",
+				Price: num.NewAmount(10000, 2),
+			},
+			Taxes: tax.Set{
+				{Category: tax.CategoryVAT, Rate: "general"},
+			},
+		})
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "21.00", inv.Totals.Tax.String())
+		assert.Equal(t, "71.16", inv.Totals.MarginTax.String())
+		err := rules.Validate(inv)
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-12]")
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-13]")
+	})
+	t.Run("remove included taxes", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.Lines = inv.Lines[:1]
+		inv.Lines[0].Item.Price = num.NewAmount(12100, 2)
+		inv.Lines[0].PurchaseCost = num.NewAmount(10000, 2)
+		inv.Tax = &bill.Tax{PricesInclude: tax.CategoryVAT}
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, inv.RemoveIncludedTaxes())
+		assert.Equal(t, "121.00", inv.Lines[0].Item.Price.String())
+		assert.Equal(t, "21.00", inv.Totals.Margin.String())
+		assert.Equal(t, "3.64", inv.Totals.MarginTax.String())
+		assert.Equal(t, "121.00", inv.Totals.Payable.String())
+		assert.Nil(t, inv.Totals.Rounding)
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("with discounts and charges", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.Discounts = []*bill.Discount{
+			{
+				Reason: "Loyalty",
+				Amount: num.MakeAmount(1000, 2),
+				Taxes:  tax.Set{{Category: tax.CategoryVAT, Rate: "general"}},
+			},
+		}
+		inv.Charges = []*bill.Charge{
+			{
+				Reason: "Delivery",
+				Amount: num.MakeAmount(5000, 2),
+				Taxes:  tax.Set{{Category: tax.CategoryVAT, Rate: "general"}},
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		err := rules.Validate(inv)
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-15] ($.discounts) invoice discounts are not allowed with the margin scheme")
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-16] ($.charges) invoice charges are not allowed with the margin scheme")
+	})
+	t.Run("with retained taxes", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.Lines[1].Taxes = append(inv.Lines[1].Taxes, &tax.Combo{
+			Category: "IRPF",
+			Percent:  num.NewPercentage(15, 2),
+		})
+		require.NoError(t, inv.Calculate())
+		assert.Nil(t, inv.Totals.RetainedTax)
+		err := rules.Validate(inv)
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-17] ($.lines[1].taxes[1]) invoice line taxes must not be retained with the margin scheme")
+	})
+	t.Run("without tag", func(t *testing.T) {
+		inv := marginInvoice(t)
+		inv.SetTags()
+		require.NoError(t, inv.Calculate())
+		assert.Nil(t, inv.Totals.Margin)
+		assert.Nil(t, inv.Totals.MarginTax)
+		assert.Equal(t, "359.10", inv.Totals.Tax.String())
+		err := rules.Validate(inv)
+		assert.ErrorContains(t, err, "[GOBL-BILL-INVOICE-14] ($.lines[0].purchase_cost, $.lines[1].purchase_cost) invoice line purchase cost requires the margin scheme")
+	})
+	t.Run("invert", func(t *testing.T) {
+		inv := marginInvoice(t)
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, inv.Invert())
+		assert.Equal(t, "-800.00", inv.Lines[0].PurchaseCost.String())
+		assert.Equal(t, "-410.00", inv.Totals.Margin.String())
+		assert.Equal(t, "-71.16", inv.Totals.MarginTax.String())
+	})
+}
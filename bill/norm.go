@@ -18,6 +18,7 @@ func init() {
 		norm.For(normalizeInvoice),
 		norm.For(normalizeDelivery),
 		norm.For(normalizePayment),
+		norm.For(normalizeCertificate),
 		norm.For(normalizeOrder),
 		norm.For(normalizeLine),
 		norm.For(normalizeSubLine),
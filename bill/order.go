@@ -83,18 +83,18 @@ type Order struct {
 	// be left empty initially, but is **required** to **sign** the document.
 	Code cbc.Code `json:"code,omitempty" jsonschema:"title=Code"`
 	// When the invoice was created.
-	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true"`
+	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true,defaulted=true"`
 	// IssueTime is an optional field that may be useful to indicate the time of day when
 	// the order was issued. Some regions and formats may require this field to be set.
 	// An empty string will be automatically updated to reflect the current time, otherwise
 	// the field can be left with a nil value.
-	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true"`
+	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Date when the operation defined by the invoice became effective.
 	OperationDate *cal.Date `json:"op_date,omitempty" jsonschema:"title=Operation Date"`
 	// When the taxes of this invoice become accountable, if none set, the issue date is used.
 	ValueDate *cal.Date `json:"value_date,omitempty" jsonschema:"title=Value Date"`
 	// Currency for all invoice totals.
-	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true"`
+	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Exchange rates to be used when converting the invoices monetary values into other currencies.
 	ExchangeRates []*currency.ExchangeRate `json:"exchange_rates,omitempty" jsonschema:"title=Exchange Rates"`
 
@@ -184,15 +184,44 @@ func orderRules() *rules.Set {
 	)
 }
 
+// RulesContext adds the order's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (ord *Order) RulesContext() rules.WithContext {
+	return issueDateContext(ord.IssueDate)
+}
+
 // Calculate performs all the normalizations and calculations required for the order
 // totals and taxes. If the original order only includes partial calculations, this
 // will figure out what's missing.
 func (ord *Order) Calculate() error {
+	return ord.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the order's inputs: the regime determined from the supplier,
+// normalizations, and default values like the issue date and currency.
+// Calculated amounts and totals are not recorded.
+func (ord *Order) CalculateWithRecorder(rec *norm.Recorder) error {
+	return ord.calculate(rec)
+}
+
+func (ord *Order) calculate(rec *norm.Recorder) error {
 	// Try to set Regime if not already prepared from the supplier's tax ID
 	if ord.Regime.IsEmpty() {
-		ord.SetRegime(partyTaxCountry(ord.Supplier))
+		_ = rec.Record(ord, originRegime, func() error {
+			ord.SetRegime(partyTaxCountry(ord.Supplier))
+			return nil
+		})
 	}
-	norm.Normalize(ord)
+	norm.Normalize(ord, norm.WithRecorder(rec))
+
+	err := rec.Record(ord, originDefaults, func() error {
+		return calculateDefaults(ord)
+	})
+	if err != nil {
+		return err
+	}
+
 	return calculate(ord)
 }
 
@@ -260,6 +289,12 @@ func (ord *Order) getIssueTime() *cal.Time {
 func (ord *Order) getValueDate() *cal.Date {
 	return ord.ValueDate
 }
+func (ord *Order) getOperationDate() *cal.Date {
+	return ord.OperationDate
+}
+func (ord *Order) getDeliveryDate() *cal.Date {
+	return ord.Delivery.effectiveDate()
+}
 func (ord *Order) getTax() *Tax {
 	return ord.Tax
 }
@@ -98,7 +98,7 @@ type Payment struct {
 	uuid.Identify
 
 	// Type of payment document being issued.
-	Type cbc.Key `json:"type" jsonschema:"title=Type" jsonschema_extras:"calculated=true"`
+	Type cbc.Key `json:"type" jsonschema:"title=Type" jsonschema_extras:"calculated=true,defaulted=true"`
 
 	// Series is used to identify groups of payments by date, business area, project,
 	// type, customer, a combination of any, or other company specific data.
@@ -109,14 +109,14 @@ type Payment struct {
 	// be left empty initially, but is **required** to **sign** the document.
 	Code cbc.Code `json:"code,omitempty" jsonschema:"title=Code"`
 	// When the payment was issued.
-	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true"`
+	IssueDate cal.Date `json:"issue_date" jsonschema:"title=Issue Date" jsonschema_extras:"calculated=true,defaulted=true"`
 	// IssueTime is an optional field that may be useful to indicate the time of day when
 	// the payment was issued.
-	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true"`
+	IssueTime *cal.Time `json:"issue_time,omitempty" jsonschema:"title=Issue Time" jsonschema_extras:"calculated=true,defaulted=true"`
 	// When the taxes of this payment become accountable, if none set, the issue date is assumed.
 	ValueDate *cal.Date `json:"value_date,omitempty" jsonschema:"title=Value Date"`
 	// Currency for all payment totals.
-	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true"`
+	Currency currency.Code `json:"currency" jsonschema:"title=Currency" jsonschema_extras:"calculated=true,defaulted=true"`
 	// Exchange rates to be used when converting the payment's monetary values into other currencies.
 	ExchangeRates []*currency.ExchangeRate `json:"exchange_rates,omitempty" jsonschema:"title=Exchange Rates"`
 	// Extensions for additional codes that may be required.
@@ -236,21 +236,46 @@ func paymentMethodsSumMatchesTotal(val any) error {
 	return nil
 }
 
+// RulesContext adds the payment's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (pmt *Payment) RulesContext() rules.WithContext {
+	return issueDateContext(pmt.IssueDate)
+}
+
 // Calculate performs all the normalizations and calculations required for the invoice
 // totals and taxes. If the original invoice only includes partial calculations, this
 // will figure out what's missing.
 func (pmt *Payment) Calculate() error {
+	return pmt.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the payment's inputs: the regime determined from the supplier,
+// normalizations, and default values like the issue date and currency.
+// Calculated amounts and totals are not recorded.
+func (pmt *Payment) CalculateWithRecorder(rec *norm.Recorder) error {
+	return pmt.calculate(rec)
+}
+
+func (pmt *Payment) calculate(rec *norm.Recorder) error {
 	// Try to set Regime if not already prepared from the supplier's tax ID
 	if pmt.Regime.IsEmpty() {
-		pmt.SetRegime(partyTaxCountry(pmt.Supplier))
+		_ = rec.Record(pmt, originRegime, func() error {
+			pmt.SetRegime(partyTaxCountry(pmt.Supplier))
+			return nil
+		})
+	}
+	norm.Normalize(pmt, norm.WithRecorder(rec))
+	if err := rec.Record(pmt, originDefaults, pmt.calculateDefaults); err != nil {
+		return err
 	}
-	norm.Normalize(pmt)
-	return pmt.calculate()
+	return pmt.calculateTotals()
 }
 
-func (pmt *Payment) calculate() error {
+// calculateDefaults sets the issue date, and time if requested, alongside
+// the currency when missing.
+func (pmt *Payment) calculateDefaults() error {
 	r := pmt.RegimeDef()
-	rr := r.GetRoundingRule()
 
 	// Set the issue date and time
 	tz := r.TimeLocation()
@@ -271,6 +296,12 @@ func (pmt *Payment) calculate() error {
 	if pmt.Currency == currency.CodeEmpty {
 		return fmt.Errorf("currency: required, unable to determine")
 	}
+	return nil
+}
+
+func (pmt *Payment) calculateTotals() error {
+	r := pmt.RegimeDef()
+	rr := r.GetRoundingRule()
 
 	var total *num.Amount
 	for i, l := range pmt.Lines {
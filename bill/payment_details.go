@@ -1,6 +1,7 @@
 package bill
 
 import (
+	"github.com/invopop/gobl/cal"
 	"github.com/invopop/gobl/num"
 	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/pay"
@@ -53,3 +54,21 @@ func (p *PaymentDetails) totalAdvance(zero num.Amount) *num.Amount {
 	}
 	return &sum
 }
+
+// firstAdvanceDate provides the earliest date defined in the list of
+// advances, or nil if none are available.
+func (p *PaymentDetails) firstAdvanceDate() *cal.Date {
+	if p == nil {
+		return nil
+	}
+	var date *cal.Date
+	for _, a := range p.Advances {
+		if a == nil || a.Date == nil || a.Date.IsZero() {
+			continue
+		}
+		if date == nil || a.Date.Before(date.Date) {
+			date = a.Date
+		}
+	}
+	return date
+}
@@ -562,22 +562,44 @@ func (st *Status) CanSign() bool {
 	return st != nil && !st.Code.IsEmpty() && !st.IssueDate.IsZero()
 }
 
+// RulesContext adds the status's issue date to the validation context so that
+// effective-dated rules are evaluated against it.
+func (st *Status) RulesContext() rules.WithContext {
+	return issueDateContext(st.IssueDate)
+}
+
 // Calculate performs all the normalizations and calculations required for
 // the status document.
 func (st *Status) Calculate() error {
-	if st.Regime.IsEmpty() {
-		st.SetRegime(partyTaxCountry(st.Supplier))
-	}
-	norm.Normalize(st)
-	return st.calculate()
+	return st.calculate(nil)
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records the changes
+// made to the status: the regime determined from the supplier,
+// normalizations, and the default issue date.
+func (st *Status) CalculateWithRecorder(rec *norm.Recorder) error {
+	return st.calculate(rec)
 }
 
-func (st *Status) calculate() error {
-	// Autofill the issue date when not provided. The issue time is optional.
-	if st.IssueDate.IsZero() {
-		st.IssueDate = cal.Today()
+func (st *Status) calculate(rec *norm.Recorder) error {
+	if st.Regime.IsEmpty() {
+		_ = rec.Record(st, originRegime, func() error {
+			st.SetRegime(partyTaxCountry(st.Supplier))
+			return nil
+		})
 	}
+	norm.Normalize(st, norm.WithRecorder(rec))
+	_ = rec.Record(st, originDefaults, func() error {
+		// Autofill the issue date when not provided. The issue time is optional.
+		if st.IssueDate.IsZero() {
+			st.IssueDate = cal.Today()
+		}
+		return nil
+	})
+	return st.calculateLines()
+}
 
+func (st *Status) calculateLines() error {
 	// Index lines
 	for i, l := range st.Lines {
 		if l == nil {
@@ -24,7 +24,8 @@ type Tax struct {
 	Rounding cbc.Key `json:"rounding,omitempty" jsonschema:"title=Rounding Model"`
 
 	// Point is a code that identifies the event which triggers the tax liability,
-	// such as invoice issuance, delivery of goods, or receipt of payment.
+	// such as invoice issuance, delivery of goods, or receipt of payment. When set,
+	// the date of the event will be used to determine the tax rates to apply.
 	Point cbc.Key `json:"point,omitempty" jsonschema:"title=Point"`
 
 	// Additional extensions that are applied to the invoice as a whole as opposed to specific
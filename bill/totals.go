@@ -24,6 +24,11 @@ type Totals struct {
 	Tax num.Amount `json:"tax,omitempty" jsonschema:"title=Tax"`
 	// Final total amount after applying indirect taxes.
 	TotalWithTax num.Amount `json:"total_with_tax" jsonschema:"title=Total with Tax"`
+	// Sum of the margins from lines under the margin scheme, with tax included.
+	Margin *num.Amount `json:"margin,omitempty" jsonschema:"title=Margin"`
+	// Non-disclosed tax included in the margin of lines under the margin scheme, which
+	// is not itemised to the customer but must be reported by the supplier.
+	MarginTax *num.Amount `json:"margin_tax,omitempty" jsonschema:"title=Margin Tax"`
 	// Total tax amount retained or withheld by the customer to be paid to the tax authority.
 	RetainedTax *num.Amount `json:"retained_tax,omitempty" jsonschema:"title=Retained Tax"`
 	// Adjustment amount applied to the invoice totals to meet rounding rules or expectations.
@@ -51,6 +56,8 @@ func (t *Totals) reset(zero num.Amount) {
 	t.Taxes = nil
 	t.Tax = zero
 	t.TotalWithTax = zero
+	t.Margin = nil
+	t.MarginTax = nil
 	t.RetainedTax = nil
 	// t.Rounding = nil // may have been provided externally
 	t.Payable = zero
@@ -84,6 +91,12 @@ func (t *Totals) round(zero num.Amount) {
 	}
 	t.Tax = t.Tax.Rescale(e)
 	t.TotalWithTax = t.TotalWithTax.Rescale(e)
+	if t.Margin != nil {
+		*t.Margin = t.Margin.Rescale(e)
+	}
+	if t.MarginTax != nil {
+		*t.MarginTax = t.MarginTax.Rescale(e)
+	}
 	if t.RetainedTax != nil {
 		*t.RetainedTax = t.RetainedTax.Rescale(e)
 	}
@@ -42,3 +42,25 @@ func periodEndNotBeforeStart(val any) bool {
 	}
 	return p.End.DaysSince(p.Start.Date) >= 0
 }
+
+// PeriodInYear provides a rules test that checks that the start and end dates
+// of a period are in the same calendar year, as typically required by annual
+// tax declarations.
+func PeriodInYear() rules.Test {
+	return is.Func("in one calendar year", periodInYear)
+}
+
+func periodInYear(val any) bool {
+	p, ok := val.(*Period)
+	if !ok {
+		pv, ok := val.(Period)
+		if !ok {
+			return true
+		}
+		p = &pv
+	}
+	if p == nil || p.Start.IsZero() || p.End.IsZero() {
+		return true
+	}
+	return p.Start.Year == p.End.Year
+}
@@ -73,3 +73,19 @@ func TestPeriodValidation(t *testing.T) {
 		assert.True(t, faults.HasCode("GOBL-CAL-PERIOD-02"))
 	})
 }
+
+func TestPeriodInYear(t *testing.T) {
+	test := cal.PeriodInYear()
+	assert.Equal(t, "in one calendar year", test.String())
+	p := cal.Period{
+		Start: cal.MakeDate(2024, 1, 1),
+		End:   cal.MakeDate(2024, 12, 31),
+	}
+	assert.True(t, test.Check(p))
+	assert.True(t, test.Check(&p))
+	p.End = cal.MakeDate(2025, 1, 1)
+	assert.False(t, test.Check(p))
+	assert.False(t, test.Check(&p))
+	assert.True(t, test.Check(cal.Period{End: p.End}), "incomplete periods are checked elsewhere")
+	assert.True(t, test.Check(nil))
+}
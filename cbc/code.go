@@ -136,7 +136,7 @@ func codeRules() *rules.Set {
 
 // Validate ensures that the code complies with the expected rules.
 func (c Code) Validate() error {
-	return rules.Validate(c)
+	return rules.ValidateErrors(c)
 }
 
 // IsEmpty returns true if no code is specified.
@@ -251,7 +251,7 @@ func codeMapKeysValid(v any) bool {
 		return false
 	}
 	for k := range m {
-		if rules.Validate(k) != nil {
+		if rules.ValidateErrors(k) != nil {
 			return false
 		}
 	}
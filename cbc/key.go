@@ -48,12 +48,30 @@ func keyRules() *rules.Set {
 		rules.Assert("01", fmt.Sprintf("key must be between %d and %d characters long", KeyMinLength, KeyMaxLength),
 			is.Length(int(KeyMinLength), int(KeyMaxLength)),
 		),
-		rules.Assert("02", "key must match the required pattern",
-			is.Matches(KeyPattern),
+		rules.Autofix(fixKey,
+			rules.Assert("02", "key must match the required pattern",
+				is.Matches(KeyPattern),
+			),
 		),
 	)
 }
 
+// fixKey converts keys to lower case, replacing spaces and underscores
+// with dashes.
+func fixKey(ptr any) bool {
+	k, ok := ptr.(*Key)
+	if !ok {
+		return false
+	}
+	v := strings.ToLower(strings.TrimSpace(string(*k)))
+	v = strings.NewReplacer(" ", "-", "_", "-").Replace(v)
+	if v == string(*k) {
+		return false
+	}
+	*k = Key(v)
+	return true
+}
+
 // String provides string representation of key
 func (k Key) String() string {
 	return string(k)
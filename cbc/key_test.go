@@ -43,6 +43,20 @@ func TestKey(t *testing.T) {
 	}
 }
 
+func TestKeyFix(t *testing.T) {
+	k := cbc.Key(" Reverse_Charge ")
+	fixed, faults := rules.Fix(&k)
+	assert.NoError(t, faults)
+	require.Error(t, fixed)
+	assert.True(t, fixed.First().Fixable())
+	assert.Equal(t, cbc.Key("reverse-charge"), k)
+
+	k = cbc.Key("-a")
+	fixed, faults = rules.Fix(&k)
+	assert.NoError(t, fixed)
+	assert.Error(t, faults)
+}
+
 func TestStringsToKeys(t *testing.T) {
 	t.Run("basic", func(t *testing.T) {
 		list := []string{
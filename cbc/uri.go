@@ -73,7 +73,7 @@ func (u URI) Path() string {
 
 // Validate ensures the URI is well-formed.
 func (u URI) Validate() error {
-	return rules.Validate(u)
+	return rules.ValidateErrors(u)
 }
 
 // JSONSchema provides a representation of the type for usage in schemas.
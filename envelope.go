@@ -3,12 +3,14 @@ package gobl
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"strings"
 
 	"github.com/invopop/gobl/c14n"
 	"github.com/invopop/gobl/dsig"
 	"github.com/invopop/gobl/head"
+	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/rules/is"
@@ -166,8 +168,22 @@ func validDigest(val any) bool {
 }
 
 // Validate ensures that the envelope contains everything it should to be considered valid GOBL.
+// Faults with a warning or info severity do not cause validation to fail, use
+// ValidateWithWarnings to retrieve them.
 func (e *Envelope) Validate() error {
-	return wrapError(rules.Validate(e))
+	_, err := e.ValidateWithWarnings()
+	return err
+}
+
+// ValidateWithWarnings performs the same checks as Validate, and additionally
+// returns any faults with a warning or info severity. Warnings are returned
+// even when the envelope is invalid.
+func (e *Envelope) ValidateWithWarnings() (rules.Faults, error) {
+	faults := rules.Validate(e)
+	if faults == nil {
+		return nil, nil
+	}
+	return faults.Warnings(), wrapError(faults.Errors())
 }
 
 // RulesContext injects validation directives carried on the envelope header
@@ -271,7 +287,7 @@ func (e *Envelope) Insert(doc interface{}) error {
 		}
 	}
 
-	if err := e.calculate(); err != nil {
+	if err := e.calculate(nil); err != nil {
 		return wrapError(err)
 	}
 
@@ -290,15 +306,34 @@ func (e *Envelope) Calculate() error {
 		return ErrNoDocument
 	}
 
-	return e.calculate()
+	return e.calculate(nil)
 }
 
-func (e *Envelope) calculate() error {
+// CalculateWithRecorder behaves like Calculate, but also records in the
+// recorder the changes made to the document's inputs during calculation, so
+// that they may be reviewed. Only documents that support recording, currently
+// the bill documents, may be calculated this way.
+func (e *Envelope) CalculateWithRecorder(rec *norm.Recorder) error {
+	if e.Document == nil {
+		return ErrNoDocument
+	}
+	if e.Document.IsEmpty() {
+		return ErrNoDocument
+	}
+
+	return e.calculate(rec)
+}
+
+func (e *Envelope) calculate(rec *norm.Recorder) error {
 	// Always set our schema version
 	e.Schema = EnvelopeSchema
 
 	// arm doors and cross check
-	if err := e.Document.Calculate(); err != nil {
+	if rec != nil {
+		if err := e.Document.CalculateWithRecorder(rec); err != nil {
+			return ErrCalculation.WithCause(err)
+		}
+	} else if err := e.Document.Calculate(); err != nil {
 		return ErrCalculation.WithCause(err)
 	}
 
@@ -319,6 +354,49 @@ func (e *Envelope) calculate() error {
 	return nil
 }
 
+// Patch applies a JSON Patch (RFC 6902) or JSON Merge Patch (RFC 7396) to the
+// envelope's document, recalculates the envelope, and validates the result.
+// Signed envelopes cannot be patched, and patches that attempt to modify
+// fields that are always calculated, such as totals, are rejected. See schema.Object.Patch for details. The
+// envelope is left untouched if the patch cannot be applied or the result is
+// invalid.
+func (e *Envelope) Patch(data []byte) error {
+	if e.Signed() {
+		return ErrSignature.WithReason("signed envelopes cannot be patched")
+	}
+	if e.Document == nil || e.Document.IsEmpty() {
+		return ErrNoDocument
+	}
+	doc, err := e.Document.Clone()
+	if err != nil {
+		return wrapError(err)
+	}
+	if err := doc.Patch(data); err != nil {
+		if _, ok := err.(rules.Faults); ok {
+			return wrapError(err)
+		}
+		if errors.Is(err, schema.ErrPatch) {
+			return ErrInput.WithCause(err)
+		}
+		return ErrCalculation.WithCause(err)
+	}
+
+	ne := *e
+	if e.Head != nil {
+		h := *e.Head
+		ne.Head = &h
+	}
+	ne.Document = doc
+	if err := ne.calculate(nil); err != nil {
+		return wrapError(err)
+	}
+	if err := ne.Validate(); err != nil {
+		return err
+	}
+	*e = ne
+	return nil
+}
+
 // normalizeRouting populates Head.From / Head.To from the embedded
 // document when (a) the document implements EndpointResolver and (b)
 // the relevant header field is empty. Operator-set From / To values
@@ -18,9 +18,11 @@ import (
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/dsig"
 	"github.com/invopop/gobl/head"
+	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/note"
 	"github.com/invopop/gobl/org"
 	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
 	"github.com/invopop/gobl/schema"
 	"github.com/invopop/gobl/uuid"
 )
@@ -120,6 +122,59 @@ func TestEnvelopeDocument(t *testing.T) {
 	assert.Equal(t, m.Content, nm.Content, "content mismatch")
 }
 
+func init() {
+	// Warnings are only raised for messages prepared by TestEnvelopeValidateWithWarnings.
+	rules.Register("gobl_test", rules.GOBL.Add("TEST"),
+		rules.For(new(note.Message),
+			rules.When(is.Expr(`Title == "warn"`),
+				rules.Warn(
+					rules.Field("meta",
+						rules.Assert("01", "meta data is recommended", is.Present),
+					),
+				),
+			),
+		),
+	)
+}
+
+func TestEnvelopeValidateWithWarnings(t *testing.T) {
+	t.Run("no faults", func(t *testing.T) {
+		e := gobl.NewEnvelope()
+		require.NoError(t, e.Insert(testNoteExample()))
+		warnings, err := e.ValidateWithWarnings()
+		assert.NoError(t, err)
+		assert.Nil(t, warnings)
+	})
+	t.Run("warnings only", func(t *testing.T) {
+		m := testNoteExample()
+		m.Title = "warn"
+		e := gobl.NewEnvelope()
+		require.NoError(t, e.Insert(m))
+		assert.NoError(t, e.Validate())
+		warnings, err := e.ValidateWithWarnings()
+		assert.NoError(t, err)
+		require.NotNil(t, warnings)
+		assert.Equal(t, 1, warnings.Len())
+		f := warnings.First()
+		assert.Equal(t, rules.SeverityWarning, f.Severity())
+		assert.Equal(t, rules.Code("GOBL-TEST-NOTE-MESSAGE-01"), f.Code())
+		assert.NoError(t, e.Sign(testKey))
+	})
+	t.Run("errors and warnings", func(t *testing.T) {
+		m := testNoteExample()
+		m.Title = "warn"
+		m.Content = ""
+		e := gobl.NewEnvelope()
+		require.NoError(t, e.Insert(m))
+		warnings, err := e.ValidateWithWarnings()
+		assert.ErrorIs(t, err, gobl.ErrValidation)
+		assert.NotContains(t, err.Error(), "GOBL-TEST-NOTE-MESSAGE-01")
+		require.NotNil(t, warnings)
+		assert.True(t, warnings.HasCode("GOBL-TEST-NOTE-MESSAGE-01"))
+		assert.ErrorIs(t, e.Validate(), gobl.ErrValidation)
+	})
+}
+
 func TestEnvelopeExtract(t *testing.T) {
 	e := &gobl.Envelope{}
 	obj := e.Extract()
@@ -180,6 +235,45 @@ func TestEnvelopeCalculate(t *testing.T) {
 	})
 }
 
+func TestEnvelopeCalculateWithRecorder(t *testing.T) {
+	t.Run("invoice", func(t *testing.T) {
+		data, err := os.ReadFile("./examples/es/invoice-es-es.env.yaml")
+		require.NoError(t, err)
+		env := gobl.NewEnvelope()
+		require.NoError(t, yaml.Unmarshal(data, env))
+		inv := env.Extract().(*bill.Invoice)
+		inv.Customer.Name = " " + inv.Customer.Name + " "
+
+		rec := norm.NewRecorder()
+		require.NoError(t, env.CalculateWithRecorder(rec))
+		assert.NotEmpty(t, env.Head.Digest)
+		inv = env.Extract().(*bill.Invoice)
+
+		var found bool
+		for _, c := range rec.Changes() {
+			if c.Path == "/customer/name" {
+				found = true
+				assert.Equal(t, inv.Customer.Name, c.New)
+				assert.Equal(t, "github.com/invopop/gobl/org.normalizeParty", c.Origin)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("unsupported document", func(t *testing.T) {
+		env := gobl.NewEnvelope()
+		require.NoError(t, env.Insert(testNoteExample()))
+		err := env.CalculateWithRecorder(norm.NewRecorder())
+		assert.ErrorIs(t, err, gobl.ErrCalculation)
+		assert.ErrorContains(t, err, "document cannot record changes")
+	})
+
+	t.Run("no document", func(t *testing.T) {
+		env := gobl.NewEnvelope()
+		assert.ErrorIs(t, env.CalculateWithRecorder(norm.NewRecorder()), gobl.ErrNoDocument)
+	})
+}
+
 func TestEnvelopeComplete(t *testing.T) {
 	e := new(gobl.Envelope)
 
@@ -486,6 +580,68 @@ func TestEnvelopeReplicate(t *testing.T) {
 	})
 }
 
+func TestEnvelopePatch(t *testing.T) {
+	loadEnvelope := func(t *testing.T) *gobl.Envelope {
+		t.Helper()
+		data, err := os.ReadFile("./examples/es/invoice-es-es.env.yaml")
+		require.NoError(t, err)
+		env := gobl.NewEnvelope()
+		require.NoError(t, yaml.Unmarshal(data, env))
+		require.NoError(t, env.Calculate())
+		return env
+	}
+
+	t.Run("json patch", func(t *testing.T) {
+		env := loadEnvelope(t)
+		digest := env.Head.Digest
+		err := env.Patch([]byte(`[{"op":"replace","path":"/code","value":"SAMPLE-002"}]`))
+		require.NoError(t, err)
+		inv := env.Extract().(*bill.Invoice)
+		assert.Equal(t, "SAMPLE-002", inv.Code.String())
+		assert.NotEqual(t, digest.Value, env.Head.Digest.Value, "digest updated")
+		assert.NoError(t, env.Validate())
+	})
+
+	t.Run("merge patch", func(t *testing.T) {
+		env := loadEnvelope(t)
+		err := env.Patch([]byte(`{"lines":[{"quantity":"2","item":{"name":"Development services","price":"90.00"},"taxes":[{"cat":"VAT","rate":"general"}]}]}`))
+		require.NoError(t, err)
+		inv := env.Extract().(*bill.Invoice)
+		require.Len(t, inv.Lines, 1)
+		assert.Equal(t, "180.00", inv.Totals.Sum.String())
+	})
+
+	t.Run("calculated field", func(t *testing.T) {
+		env := loadEnvelope(t)
+		err := env.Patch([]byte(`[{"op":"replace","path":"/totals/payable","value":"1.00"}]`))
+		assert.ErrorIs(t, err, gobl.ErrInput)
+		assert.ErrorContains(t, err, "input: patch: /totals/payable is calculated and cannot be modified")
+	})
+
+	t.Run("invalid result", func(t *testing.T) {
+		env := loadEnvelope(t)
+		digest := env.Head.Digest
+		err := env.Patch([]byte(`{"supplier":{"name":null}}`))
+		assert.ErrorIs(t, err, gobl.ErrValidation)
+		assert.Equal(t, digest, env.Head.Digest, "envelope unchanged")
+		assert.Equal(t, "Provide One S.L.", env.Extract().(*bill.Invoice).Supplier.Name)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		env := loadEnvelope(t)
+		require.NoError(t, env.Sign(testKey))
+		err := env.Patch([]byte(`{"code":"SAMPLE-002"}`))
+		assert.ErrorIs(t, err, gobl.ErrSignature)
+		assert.ErrorContains(t, err, "signature: signed envelopes cannot be patched")
+	})
+
+	t.Run("no document", func(t *testing.T) {
+		env := gobl.NewEnvelope()
+		err := env.Patch([]byte(`{"code":"SAMPLE-002"}`))
+		assert.ErrorIs(t, err, gobl.ErrNoDocument)
+	})
+}
+
 func TestDocument(t *testing.T) {
 	msg := testNoteExample()
 	env := gobl.NewEnvelope()
@@ -556,14 +712,14 @@ func TestDocumentValidationOutput(t *testing.T) {
 	err = rules.Validate(doc)
 	data, err := json.Marshal(err)
 	require.NoError(t, err)
-	assert.Equal(t, `[{"code":"GOBL-NOTE-MESSAGE-01","paths":["$.content"],"message":"message content is required"}]`, string(data))
+	assert.Equal(t, `[{"code":"GOBL-NOTE-MESSAGE-01","paths":["$.content"],"message":"message content is required","severity":"error"}]`, string(data))
 
 	env := gobl.NewEnvelope()
 	require.NoError(t, env.Insert(msg))
 	err = env.Validate()
 	data, err = json.Marshal(err)
 	require.NoError(t, err)
-	assert.JSONEq(t, `{"key":"validation","faults":[{"paths":["$.doc.content"],"code":"GOBL-NOTE-MESSAGE-01","message":"message content is required"}]}`, string(data))
+	assert.JSONEq(t, `{"key":"validation","faults":[{"paths":["$.doc.content"],"code":"GOBL-NOTE-MESSAGE-01","message":"message content is required","severity":"error"}]}`, string(data))
 }
 
 func TestEnvelopeVerify(t *testing.T) {
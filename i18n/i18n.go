@@ -1,23 +1,6 @@
 // Package i18n provides internationalization models.
+//
+// The package has no dependencies on other GOBL packages so that it may be
+// used to translate the fault messages of the rules package. Its schema and
+// rules are registered by the schema package.
 package i18n
-
-import (
-	"github.com/invopop/gobl/rules"
-	"github.com/invopop/gobl/rules/is"
-	"github.com/invopop/gobl/schema"
-)
-
-func init() {
-	schema.Register(schema.GOBL.Add("i18n"), String{})
-	rules.Register(
-		"i18n",
-		rules.GOBL.Add("I18N"),
-		langRules(),
-	)
-}
-
-func langRules() *rules.Set {
-	return rules.For(Lang(""),
-		rules.Assert("01", "must be a valid language code", is.In(validLangValues()...)),
-	)
-}
@@ -20,14 +20,6 @@ type LangDef struct {
 	Name string `json:"name" jsonschema:"title=Name"`
 }
 
-func validLangValues() []any {
-	list := make([]any, len(LangDefinitions))
-	for i, d := range LangDefinitions {
-		list[i] = string(d.Code)
-	}
-	return list
-}
-
 // JSONSchema provides a representation of the struct for usage in Schema.
 func (Lang) JSONSchema() *jsonschema.Schema {
 	s := &jsonschema.Schema{
@@ -1,6 +1,11 @@
 package i18n
 
-import "github.com/invopop/jsonschema"
+import (
+	"maps"
+	"slices"
+
+	"github.com/invopop/jsonschema"
+)
 
 const (
 	defaultLanguage = EN
@@ -26,15 +31,16 @@ func (s String) In(lang Lang) string {
 	return s.String()
 }
 
-// String returns the default language string or first entry found.
+// String returns the default language string, or the text of the first
+// language in alphabetical order if not available.
 func (s String) String() string {
 	if v, ok := s[defaultLanguage]; ok {
 		return v
 	}
-	for _, v := range s {
-		return v // provide first entry
+	if len(s) == 0 {
+		return ""
 	}
-	return ""
+	return s[slices.Min(slices.Collect(maps.Keys(s)))]
 }
 
 // IsEmpty returns true if the string map is empty.
@@ -24,6 +24,14 @@ func TestI18nString(t *testing.T) {
 	assert.Equal(t, "Foo", snd.In("en"))
 	assert.Equal(t, "Foo", snd.String())
 
+	thd := i18n.String{
+		i18n.PT: "Teste",
+		i18n.ES: "Prueba",
+		i18n.IT: "Prova",
+	}
+	assert.Equal(t, "Prueba", thd.String(), "first language in order")
+	assert.Equal(t, "", i18n.String{}.String())
+
 	s2 := i18n.NewString("Test")
 	assert.Equal(t, "Test", s2.In("en"))
 }
@@ -0,0 +1,178 @@
+// Package migrate provides a registry of transformations used to upgrade
+// stored GOBL documents and envelopes created with older versions of GOBL
+// whose structure is no longer supported.
+//
+// Each Step targets documents with a specific schema ID and is associated
+// with the GOBL version that introduced the breaking change. Steps operate on
+// the raw JSON data, decoded into generic maps and slices, so that documents
+// may be upgraded before attempting to parse them:
+//
+//	data, reports, err := migrate.Upgrade(data, "v0.115.0")
+//
+// All the steps registered for versions after the one provided are applied,
+// in version order, and a report is provided for each.
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/invopop/gobl/schema"
+)
+
+// envelopeSchema is the schema ID of GOBL envelopes, defined here to avoid
+// importing the root package.
+var envelopeSchema = schema.GOBL.Add("envelope")
+
+// Func transforms a JSON object decoded into generic maps and slices,
+// returning true if any changes were made.
+type Func func(doc map[string]any) (bool, error)
+
+// Step describes a single transformation required to upgrade documents with
+// a given schema to the structure expected from a GOBL version onwards.
+type Step struct {
+	// Schema is the ID of the documents the step applies to.
+	Schema schema.ID
+	// Version is the GOBL version that introduced the change. Documents
+	// created with earlier versions will be upgraded.
+	Version string
+	// Desc describes the change made by the step.
+	Desc string
+	// Func performs the transformation.
+	Func Func
+
+	version *semver.Version
+}
+
+// Report describes the outcome of applying a single step.
+type Report struct {
+	// Schema is the ID of the document the step was applied to.
+	Schema schema.ID `json:"schema"`
+	// Version is the GOBL version associated with the step.
+	Version string `json:"version"`
+	// Desc describes the change made by the step.
+	Desc string `json:"desc"`
+	// Changed is true when the step modified the document.
+	Changed bool `json:"changed"`
+}
+
+var registry = make(map[schema.ID][]*Step)
+
+// Register adds the steps to the global registry, typically from a package's
+// init function. Steps for the same schema are applied in version order, and
+// in the order they were registered for the same version. Register panics if
+// a step is incomplete or its version is invalid.
+func Register(steps ...*Step) {
+	for _, s := range steps {
+		if s.Schema == "" || s.Func == nil {
+			panic(fmt.Sprintf("migrate: step %q requires a schema and func", s.Desc))
+		}
+		v, err := semver.NewVersion(s.Version)
+		if err != nil {
+			panic(fmt.Sprintf("migrate: step %q has an invalid version %q: %s", s.Desc, s.Version, err.Error()))
+		}
+		s.version = v
+		list := append(registry[s.Schema], s)
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].version.LessThan(list[j].version)
+		})
+		registry[s.Schema] = list
+	}
+}
+
+// Steps returns the steps registered for the schema that apply to documents
+// created with the given GOBL version, in the order they will be applied.
+func Steps(id schema.ID, from string) ([]*Step, error) {
+	v, err := semver.NewVersion(from)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: invalid version %q: %w", from, err)
+	}
+	var steps []*Step
+	for _, s := range registry[id] {
+		if s.version.GreaterThan(v) {
+			steps = append(steps, s)
+		}
+	}
+	return steps, nil
+}
+
+// Upgrade applies the steps required to upgrade the JSON envelope or document
+// created with the GOBL version provided. For envelopes, steps are applied to
+// both the envelope and the document it contains. The original data is
+// returned untouched when no step made any changes.
+//
+// Upgrade does not update the envelope's header digest or signatures, so
+// envelopes whose documents were changed will need to be recalculated and
+// signed again before they can be validated.
+func Upgrade(data []byte, from string) ([]byte, []*Report, error) {
+	obj, err := decode(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	reports, err := upgrade(obj, from)
+	if err != nil {
+		return nil, reports, err
+	}
+	if doc, ok := obj["doc"].(map[string]any); ok && schemaOf(obj) == envelopeSchema {
+		rs, err := upgrade(doc, from)
+		reports = append(reports, rs...)
+		if err != nil {
+			return nil, reports, err
+		}
+	}
+	changed := false
+	for _, r := range reports {
+		changed = changed || r.Changed
+	}
+	if !changed {
+		return data, reports, nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(obj); err != nil {
+		return nil, reports, fmt.Errorf("migrate: encoding: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), reports, nil
+}
+
+// upgrade applies the steps for the object's schema.
+func upgrade(obj map[string]any, from string) ([]*Report, error) {
+	id := schemaOf(obj)
+	steps, err := Steps(id, from)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*Report, 0, len(steps))
+	for _, s := range steps {
+		changed, err := s.Func(obj)
+		if err != nil {
+			return reports, fmt.Errorf("migrate: %s %s: %w", id, s.Version, err)
+		}
+		reports = append(reports, &Report{
+			Schema:  id,
+			Version: s.Version,
+			Desc:    s.Desc,
+			Changed: changed,
+		})
+	}
+	return reports, nil
+}
+
+func decode(data []byte) (map[string]any, error) {
+	obj := make(map[string]any)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("migrate: decoding: %w", err)
+	}
+	return obj, nil
+}
+
+func schemaOf(obj map[string]any) schema.ID {
+	id, _ := obj["$schema"].(string)
+	return schema.ID(id)
+}
@@ -0,0 +1,133 @@
+package migrate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/invopop/gobl/migrate"
+	"github.com/invopop/gobl/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = schema.GOBL.Add("test/migrate")
+
+func init() {
+	migrate.Register(
+		&migrate.Step{
+			Schema:  testSchema,
+			Version: "v0.300.0",
+			Desc:    "rename title to name",
+			Func: func(doc map[string]any) (bool, error) {
+				v, ok := doc["title"]
+				if !ok {
+					return false, nil
+				}
+				doc["name"] = v
+				delete(doc, "title")
+				return true, nil
+			},
+		},
+		&migrate.Step{
+			Schema:  testSchema,
+			Version: "v0.200.0",
+			Desc:    "wrap code in an object",
+			Func: func(doc map[string]any) (bool, error) {
+				c, ok := doc["code"].(string)
+				if !ok {
+					return false, nil
+				}
+				doc["code"] = map[string]any{"value": c}
+				return true, nil
+			},
+		},
+		&migrate.Step{
+			Schema:  testSchema,
+			Version: "v0.300.0",
+			Desc:    "fail on bad data",
+			Func: func(doc map[string]any) (bool, error) {
+				if doc["bad"] != nil {
+					return false, errors.New("bad data")
+				}
+				return false, nil
+			},
+		},
+	)
+}
+
+func TestSteps(t *testing.T) {
+	steps, err := migrate.Steps(testSchema, "v0.100.0")
+	require.NoError(t, err)
+	require.Len(t, steps, 3)
+	assert.Equal(t, "v0.200.0", steps[0].Version)
+	assert.Equal(t, "rename title to name", steps[1].Desc)
+
+	steps, err = migrate.Steps(testSchema, "v0.200.0")
+	require.NoError(t, err)
+	assert.Len(t, steps, 2)
+
+	steps, err = migrate.Steps(testSchema, "v0.300.0")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+
+	_, err = migrate.Steps(testSchema, "latest")
+	assert.ErrorContains(t, err, `migrate: invalid version "latest"`)
+}
+
+func TestUpgrade(t *testing.T) {
+	t.Run("document", func(t *testing.T) {
+		data := []byte(`{"$schema":"https://gobl.org/draft-0/test/migrate","title":"Test <1>","code":"A1","amount":10.50}`)
+		out, reports, err := migrate.Upgrade(data, "v0.100.0")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"$schema":"https://gobl.org/draft-0/test/migrate","name":"Test <1>","code":{"value":"A1"},"amount":10.50}`, string(out))
+		assert.Contains(t, string(out), `"amount":10.50`, "numbers are preserved")
+		require.Len(t, reports, 3)
+		assert.Equal(t, "v0.200.0", reports[0].Version)
+		assert.True(t, reports[0].Changed)
+		assert.True(t, reports[1].Changed)
+		assert.False(t, reports[2].Changed)
+		assert.Equal(t, testSchema, reports[2].Schema)
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		data := []byte(`{"$schema":"https://gobl.org/draft-0/envelope","head":{},"doc":{"$schema":"https://gobl.org/draft-0/test/migrate","title":"Test"}}`)
+		out, reports, err := migrate.Upgrade(data, "v0.250.0")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"$schema":"https://gobl.org/draft-0/envelope","head":{},"doc":{"$schema":"https://gobl.org/draft-0/test/migrate","name":"Test"}}`, string(out))
+		assert.Len(t, reports, 2)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		data := []byte(`{"$schema": "https://gobl.org/draft-0/test/migrate", "name": "Test"}`)
+		out, reports, err := migrate.Upgrade(data, "v0.100.0")
+		require.NoError(t, err)
+		assert.Equal(t, data, out)
+		assert.Len(t, reports, 3)
+	})
+
+	t.Run("step error", func(t *testing.T) {
+		data := []byte(`{"$schema":"https://gobl.org/draft-0/test/migrate","bad":true}`)
+		_, reports, err := migrate.Upgrade(data, "v0.100.0")
+		assert.ErrorContains(t, err, "migrate: https://gobl.org/draft-0/test/migrate v0.300.0: bad data")
+		assert.Len(t, reports, 2)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, _, err := migrate.Upgrade([]byte(`[]`), "v0.100.0")
+		assert.ErrorContains(t, err, "migrate: decoding")
+	})
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	assert.PanicsWithValue(t, `migrate: step "foo" has an invalid version "v1.x": Invalid Semantic Version`, func() {
+		migrate.Register(&migrate.Step{
+			Schema:  testSchema,
+			Version: "v1.x",
+			Desc:    "foo",
+			Func:    func(map[string]any) (bool, error) { return false, nil },
+		})
+	})
+	assert.Panics(t, func() {
+		migrate.Register(&migrate.Step{Version: "v1.0.0", Desc: "foo"})
+	})
+}
@@ -1,7 +1,9 @@
 package norm
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/invopop/gobl/rules"
 )
@@ -27,6 +29,9 @@ type preparer interface {
 // without needing access to the root. The complete addon set (declared plus
 // dependencies) is resolved before the walk via prepare; normalizers cannot
 // add further addons during normalization.
+//
+// Use the WithRecorder option to obtain a log of the changes made, or DryRun
+// to find out what would change without modifying doc.
 func Normalize(doc any, opts ...rules.WithContext) {
 	if doc == nil {
 		return
@@ -42,7 +47,7 @@ func Normalize(doc any, opts ...rules.WithContext) {
 	}
 	prepare(doc)
 	collectContext(rc, doc)
-	walk(rc, rv)
+	walk(rc, recorderFrom(rc), rv)
 }
 
 // prepare gives the root and its exported fields a chance to finalise their
@@ -79,39 +84,41 @@ func prepare(obj any) {
 }
 
 // walk visits v, normalizing children first (post-order) and then v itself.
-func walk(rc *rules.Context, v reflect.Value) {
+// The recorder, when not nil, tracks the path to each value visited.
+func walk(rc *rules.Context, rec *Recorder, v reflect.Value) {
 	switch v.Kind() {
 	case reflect.Pointer:
 		if v.IsNil() {
 			return
 		}
-		walk(rc, v.Elem())
+		walk(rc, rec, v.Elem())
 		return
 	case reflect.Interface:
 		if v.IsNil() {
 			return
 		}
-		walk(rc, v.Elem())
+		walk(rc, rec, v.Elem())
 		return
 	case reflect.Struct:
 		t := v.Type()
 		for i := range v.NumField() {
-			if !t.Field(i).IsExported() {
+			sf := t.Field(i)
+			if !sf.IsExported() {
 				continue
 			}
-			walk(rc, v.Field(i))
+			rec.enter(jsonFieldName(sf))
+			walk(rc, rec, v.Field(i))
+			rec.leave()
 		}
 	case reflect.Slice:
 		if v.CanSet() {
-			pruneNilElements(v)
-		}
-		for i := range v.Len() {
-			walk(rc, v.Index(i))
+			rec.capture(v.Addr().Interface(), originPrune, func() {
+				pruneNilElements(v)
+			})
 		}
+		walkElements(rc, rec, v)
 	case reflect.Array:
-		for i := range v.Len() {
-			walk(rc, v.Index(i))
-		}
+		walkElements(rc, rec, v)
 	case reflect.Map:
 		// Map values are not addressable, so only pointer (or interface)
 		// values can be normalized in place by recursing into what they
@@ -120,11 +127,22 @@ func walk(rc *rules.Context, v reflect.Value) {
 		for iter.Next() {
 			mv := iter.Value()
 			if mv.Kind() == reflect.Pointer || mv.Kind() == reflect.Interface {
-				walk(rc, mv)
+				rec.enter(fmt.Sprintf("%v", iter.Key().Interface()))
+				walk(rc, rec, mv)
+				rec.leave()
 			}
 		}
 	}
-	apply(rc, v)
+	apply(rc, rec, v)
+}
+
+// walkElements visits each element of a slice or array.
+func walkElements(rc *rules.Context, rec *Recorder, v reflect.Value) {
+	for i := range v.Len() {
+		rec.enter(strconv.Itoa(i))
+		walk(rc, rec, v.Index(i))
+		rec.leave()
+	}
 }
 
 // pruneNilElements drops nil pointer/interface entries from a settable slice,
@@ -160,7 +178,7 @@ func pruneNilElements(v reflect.Value) {
 
 // apply runs the normalizers registered for v's type whose guards all pass.
 // It requires an addressable value so a pointer can be handed to the normalizer.
-func apply(rc *rules.Context, v reflect.Value) {
+func apply(rc *rules.Context, rec *Recorder, v reflect.Value) {
 	if !v.CanAddr() {
 		return
 	}
@@ -171,7 +189,7 @@ func apply(rc *rules.Context, v reflect.Value) {
 	ptr := v.Addr().Interface()
 	for _, reg := range regs {
 		if guardsPass(rc, reg.guards, ptr) {
-			reg.fn(ptr)
+			rec.capture(ptr, reg.origin, func() { reg.fn(ptr) })
 		}
 	}
 }
@@ -13,6 +13,11 @@
 // discovers nested values by reflection. Normalizers must be idempotent: the
 // engine may apply them more than once when meta-addons append further addons
 // during normalization (see Normalize).
+//
+// To find out what normalization changed, pass a Recorder to Normalize with the
+// WithRecorder option, or use DryRun to list the changes that would be made
+// without modifying the document. Each change includes the JSON Pointer of the
+// value, its old and new values, and the normalizer function responsible.
 package norm
 
 import (
@@ -32,6 +37,7 @@ type Set struct {
 	objType reflect.Type // type this set normalizes; nil for a When grouping
 	guard   rules.Test   // optional guard; from When or RegisterWithGuard
 	fn      Func         // the normalizer to run on a matching value; nil for a When grouping
+	origin  string       // name of the normalizer function, used when recording changes
 	subsets []*Set       // grouping only (When); each carries its own objType
 }
 
@@ -62,6 +68,7 @@ func For[T any](fn func(*T)) *Set {
 				fn(v)
 			}
 		},
+		origin: funcName(fn),
 	}
 }
 
@@ -273,7 +273,7 @@ func TestEngineDefensiveBranches(t *testing.T) {
 	rc := &rules.Context{}
 
 	t.Run("apply ignores non-addressable values", func(t *testing.T) {
-		assert.NotPanics(t, func() { apply(rc, reflect.ValueOf(42)) })
+		assert.NotPanics(t, func() { apply(rc, nil, reflect.ValueOf(42)) })
 	})
 
 	t.Run("non-struct pointer root is a no-op", func(t *testing.T) {
@@ -0,0 +1,227 @@
+package norm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/invopop/gobl/rules"
+)
+
+// contextKeyRecorder is used to pass a Recorder to Normalize through the
+// context options.
+const contextKeyRecorder rules.ContextKey = "norm-recorder"
+
+// originPrune identifies changes made by the engine itself when removing nil
+// elements from slices.
+const originPrune = "norm: remove null elements"
+
+// Change describes a single modification made to a document during
+// normalization.
+type Change struct {
+	// Path is the JSON Pointer (RFC 6901) of the value that changed.
+	Path string `json:"path"`
+	// Old is the JSON value before the change, or nil if the value was added.
+	Old any `json:"old,omitempty"`
+	// New is the JSON value after the change, or nil if the value was removed.
+	New any `json:"new,omitempty"`
+	// Origin is the name of the normalizer function that made the change, or
+	// the origin provided to Record.
+	Origin string `json:"origin"`
+}
+
+// Recorder keeps a log of the changes made during normalization. Prepare a
+// new recorder with NewRecorder and pass it to Normalize using the
+// WithRecorder option.
+type Recorder struct {
+	changes []*Change
+	path    []string
+}
+
+// NewRecorder prepares a new empty recorder.
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+// WithRecorder returns a normalization option that records every change made
+// to the document in the provided recorder. Changes are detected by comparing
+// the JSON representation of each value before and after each normalizer
+// runs, so recording is considerably slower and should only be used when the
+// changes are needed.
+func WithRecorder(r *Recorder) rules.WithContext {
+	return func(rc *rules.Context) {
+		rc.Set(contextKeyRecorder, r)
+	}
+}
+
+// Changes returns the changes recorded, in the order they were made.
+func (r *Recorder) Changes() []*Change {
+	return r.changes
+}
+
+// Record calls fn and records the changes it made to doc, a pointer to the
+// document being normalized, using the given origin. Use it to keep track of
+// changes made outside of normalizers, like defaults set during calculation.
+// A nil recorder will just call fn.
+func (r *Recorder) Record(doc any, origin string, fn func() error) error {
+	var err error
+	r.capture(doc, origin, func() {
+		err = fn()
+	})
+	return err
+}
+
+// DryRun normalizes a copy of doc and returns the changes that Normalize would
+// make, leaving doc untouched. The copy is prepared by encoding doc to JSON and
+// decoding the result into a new value of the same type.
+func DryRun(doc any, opts ...rules.WithContext) ([]*Change, error) {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return nil, fmt.Errorf("norm: dry run requires a non-nil pointer, got %T", doc)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("norm: preparing dry run: %w", err)
+	}
+	cp := reflect.New(rv.Elem().Type()).Interface()
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("norm: preparing dry run: %w", err)
+	}
+	rec := NewRecorder()
+	Normalize(cp, append(opts, WithRecorder(rec))...)
+	return rec.Changes(), nil
+}
+
+// recorderFrom returns the recorder provided in the context, if any.
+func recorderFrom(rc *rules.Context) *Recorder {
+	r, _ := rc.Value(contextKeyRecorder).(*Recorder)
+	return r
+}
+
+// enter adds a path segment when recording, to be removed with leave.
+func (r *Recorder) enter(seg string) {
+	if r != nil {
+		r.path = append(r.path, seg)
+	}
+}
+
+// leave removes the last path segment added with enter.
+func (r *Recorder) leave() {
+	if r != nil {
+		r.path = r.path[:len(r.path)-1]
+	}
+}
+
+// capture calls fn and records any changes it made to the value at the
+// current path. Values that cannot be encoded as JSON are not recorded.
+func (r *Recorder) capture(ptr any, origin string, fn func()) {
+	if r == nil {
+		fn()
+		return
+	}
+	before, err := json.Marshal(ptr)
+	if err != nil {
+		fn()
+		return
+	}
+	fn()
+	after, err := json.Marshal(ptr)
+	if err != nil || bytes.Equal(before, after) {
+		return
+	}
+	var path strings.Builder
+	for _, seg := range r.path {
+		if seg == "" {
+			continue
+		}
+		path.WriteString("/")
+		path.WriteString(escapePointer(seg))
+	}
+	r.diff(path.String(), decodeJSON(before), decodeJSON(after), origin)
+}
+
+// diff compares two decoded JSON values recursively, recording a change for
+// each leaf value that was added, removed or modified.
+func (r *Recorder) diff(path string, old, nw any, origin string) {
+	switch o := old.(type) {
+	case map[string]any:
+		n, ok := nw.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(o)+len(n))
+		for k := range o {
+			keys = append(keys, k)
+		}
+		for k := range n {
+			if _, ok := o[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			r.diff(path+"/"+escapePointer(k), o[k], n[k], origin)
+		}
+		return
+	case []any:
+		n, ok := nw.([]any)
+		if !ok {
+			break
+		}
+		for i := range max(len(o), len(n)) {
+			var ov, nv any
+			if i < len(o) {
+				ov = o[i]
+			}
+			if i < len(n) {
+				nv = n[i]
+			}
+			r.diff(path+"/"+strconv.Itoa(i), ov, nv, origin)
+		}
+		return
+	}
+	if reflect.DeepEqual(old, nw) {
+		return
+	}
+	r.changes = append(r.changes, &Change{Path: path, Old: old, New: nw, Origin: origin})
+}
+
+func decodeJSON(data []byte) any {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil
+	}
+	return v
+}
+
+// escapePointer escapes a JSON Pointer reference token.
+func escapePointer(seg string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(seg, "~", "~0"), "/", "~1")
+}
+
+// funcName provides the fully qualified name of a normalizer function to use
+// as the origin of recorded changes.
+func funcName(fn any) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		return f.Name()
+	}
+	return "unknown"
+}
+
+// jsonFieldName provides the JSON name of a struct field to use in recorded
+// paths, or an empty string for embedded fields whose properties are
+// flattened into the parent.
+func jsonFieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" && !sf.Anonymous {
+		return sf.Name
+	}
+	return name
+}
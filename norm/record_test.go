@@ -0,0 +1,129 @@
+package norm
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rItem struct {
+	Code string `json:"code"`
+}
+
+type rMeta struct {
+	Src string `json:"src,omitempty"`
+}
+
+type rRoot struct {
+	rMeta
+	Name  string            `json:"name"`
+	Items []*rItem          `json:"items,omitempty"`
+	Note  *rItem            `json:"note,omitempty"`
+	Map   map[string]*rItem `json:"map,omitempty"`
+}
+
+func normalizeRItem(i *rItem) {
+	i.Code = strings.ToUpper(i.Code)
+}
+
+func normalizeRRoot(r *rRoot) {
+	if r.Note == nil {
+		r.Note = &rItem{Code: "N"}
+	}
+	r.Src = "norm"
+}
+
+func init() {
+	Register(
+		For(normalizeRItem),
+		For(normalizeRRoot),
+	)
+}
+
+func TestRecorder(t *testing.T) {
+	r := &rRoot{
+		Name:  "root",
+		Items: []*rItem{{Code: "A"}, nil, {Code: "b"}},
+		Map:   map[string]*rItem{"x/y": {Code: "c"}},
+	}
+	rec := NewRecorder()
+	Normalize(r, WithRecorder(rec))
+	assert.Equal(t, "B", r.Items[1].Code)
+
+	changes := rec.Changes()
+	require.Len(t, changes, 6)
+
+	assert.Equal(t, "/items/1", changes[0].Path)
+	assert.Nil(t, changes[0].Old)
+	assert.Equal(t, map[string]any{"code": "b"}, changes[0].New)
+	assert.Equal(t, originPrune, changes[0].Origin)
+	assert.Equal(t, "/items/2", changes[1].Path, "element removed from the end")
+	assert.Nil(t, changes[1].New)
+
+	assert.Equal(t, "/items/1/code", changes[2].Path)
+	assert.Equal(t, "b", changes[2].Old)
+	assert.Equal(t, "B", changes[2].New)
+	assert.Equal(t, "github.com/invopop/gobl/norm.normalizeRItem", changes[2].Origin)
+
+	assert.Equal(t, "/map/x~1y/code", changes[3].Path)
+
+	assert.Equal(t, "/note", changes[4].Path)
+	assert.Nil(t, changes[4].Old)
+	assert.Equal(t, map[string]any{"code": "N"}, changes[4].New)
+	assert.Equal(t, "github.com/invopop/gobl/norm.normalizeRRoot", changes[4].Origin)
+
+	assert.Equal(t, "/src", changes[5].Path, "embedded fields are flattened")
+
+	data, err := json.Marshal(changes[2])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"path":"/items/1/code","old":"b","new":"B","origin":"github.com/invopop/gobl/norm.normalizeRItem"}`, string(data))
+}
+
+func TestRecorderRecord(t *testing.T) {
+	r := &rRoot{Name: "root"}
+	rec := NewRecorder()
+	err := rec.Record(r, "test: defaults", func() error {
+		r.Name = "ROOT"
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, rec.Changes(), 1)
+	assert.Equal(t, &Change{Path: "/name", Old: "root", New: "ROOT", Origin: "test: defaults"}, rec.Changes()[0])
+
+	err = rec.Record(r, "test: fail", func() error {
+		return errors.New("failed")
+	})
+	assert.ErrorContains(t, err, "failed")
+	assert.Len(t, rec.Changes(), 1)
+
+	var nr *Recorder
+	err = nr.Record(r, "test: nil", func() error {
+		r.Name = "other"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "other", r.Name)
+}
+
+func TestDryRun(t *testing.T) {
+	r := &rRoot{Name: "root", Items: []*rItem{{Code: "a"}}}
+	changes, err := DryRun(r)
+	require.NoError(t, err)
+	assert.Equal(t, "a", r.Items[0].Code, "document must not be modified")
+	assert.Nil(t, r.Note)
+	require.Len(t, changes, 3)
+	assert.Equal(t, "/items/0/code", changes[0].Path)
+
+	_, err = DryRun(rRoot{})
+	assert.ErrorContains(t, err, "norm: dry run requires a non-nil pointer")
+}
+
+func TestNormalizeWithoutRecorder(t *testing.T) {
+	r := &rRoot{Items: []*rItem{{Code: "a"}}}
+	Normalize(r)
+	assert.Equal(t, "A", r.Items[0].Code)
+}
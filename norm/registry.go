@@ -12,6 +12,7 @@ import (
 type registered struct {
 	guards []rules.Test
 	fn     Func
+	origin string
 }
 
 // typeIndex maps a target type to the normalizers registered for it, in
@@ -53,7 +54,7 @@ func flatten(s *Set, guards []rules.Test) {
 		next = append(append([]rules.Test(nil), guards...), s.guard)
 	}
 	if s.objType != nil && s.fn != nil {
-		typeIndex[s.objType] = append(typeIndex[s.objType], &registered{guards: next, fn: s.fn})
+		typeIndex[s.objType] = append(typeIndex[s.objType], &registered{guards: next, fn: s.fn, origin: s.origin})
 	}
 	for _, ss := range s.subsets {
 		flatten(ss, next)
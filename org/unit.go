@@ -2,6 +2,7 @@ package org
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/rules"
@@ -238,15 +239,38 @@ var UnitDefinitions = []DefUnit{
 
 func unitRules() *rules.Set {
 	return rules.For(Unit(""),
-		rules.Assert("01", "unit must be a valid value or UN/ECE code",
-			is.AnyOf(
-				is.MatchesRegexp(regexpUNECEUnit),
-				is.In(validUnitValues()...),
+		rules.Autofix(fixUnit,
+			rules.Assert("01", "unit must be a valid value or UN/ECE code",
+				is.AnyOf(
+					is.MatchesRegexp(regexpUNECEUnit),
+					is.In(validUnitValues()...),
+				),
 			),
 		),
 	)
 }
 
+// fixUnit corrects units provided in the wrong case, either for one of the
+// defined units or a UN/ECE code.
+func fixUnit(ptr any) bool {
+	u, ok := ptr.(*Unit)
+	if !ok {
+		return false
+	}
+	v := strings.TrimSpace(string(*u))
+	for _, def := range UnitDefinitions {
+		if strings.EqualFold(string(def.Unit), v) {
+			*u = def.Unit
+			return true
+		}
+	}
+	if v = strings.ToUpper(v); regexpUNECEUnit.MatchString(v) {
+		*u = Unit(v)
+		return true
+	}
+	return false
+}
+
 func validUnitValues() []any {
 	list := make([]any, len(UnitDefinitions))
 	for i, d := range UnitDefinitions {
@@ -257,7 +281,7 @@ func validUnitValues() []any {
 
 // Validate ensures the unit looks correct.
 func (u Unit) Validate() error {
-	return rules.Validate(u)
+	return rules.ValidateErrors(u)
 }
 
 // UNECE provides the unit's UN/ECE equivalent value.
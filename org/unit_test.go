@@ -5,7 +5,9 @@ import (
 
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUnitValidation(t *testing.T) {
@@ -28,6 +30,25 @@ func TestUnitValidation(t *testing.T) {
 	}
 }
 
+func TestUnitFix(t *testing.T) {
+	item := &org.Item{Name: "Test", Unit: "Kg"}
+	fixed, faults := rules.Fix(item)
+	assert.NoError(t, faults)
+	require.Error(t, fixed)
+	assert.True(t, fixed.HasPath("$.unit"))
+	assert.Equal(t, org.UnitKilogram, item.Unit)
+
+	u := org.Unit("xun")
+	_, faults = rules.Fix(&u)
+	assert.NoError(t, faults)
+	assert.Equal(t, org.Unit("XUN"), u)
+
+	u = org.Unit("random")
+	fixed, faults = rules.Fix(&u)
+	assert.NoError(t, fixed)
+	assert.Error(t, faults)
+}
+
 func TestUnitUNECE(t *testing.T) {
 	u := org.Unit("h")
 	assert.Equal(t, u.UNECE(), cbc.Code("HUR"))
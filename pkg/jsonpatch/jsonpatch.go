@@ -0,0 +1,478 @@
+// Package jsonpatch applies JSON Patch (RFC 6902) and JSON Merge Patch
+// (RFC 7396) documents to JSON data.
+//
+// Documents are decoded into generic maps and slices with numbers kept in
+// their original textual form, so values not touched by a patch are
+// preserved exactly. The type of patch is determined from the data: an
+// array of operations is a JSON Patch, anything else a Merge Patch.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Supported JSON Patch operations.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// Operation is a single JSON Patch operation.
+type Operation struct {
+	// Op is the operation to perform.
+	Op string `json:"op"`
+	// Path is the JSON Pointer (RFC 6901) of the target location.
+	Path string `json:"path"`
+	// From is the JSON Pointer of the source location for move and copy
+	// operations.
+	From string `json:"from,omitempty"`
+	// Value is used by the add, replace and test operations.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// IsMerge returns true when the patch provided is a JSON Merge Patch rather
+// than a list of JSON Patch operations.
+func IsMerge(patch []byte) bool {
+	patch = bytes.TrimLeft(patch, " \t\r\n")
+	return len(patch) == 0 || patch[0] != '['
+}
+
+// Apply applies the JSON Patch or Merge Patch to the document and returns
+// the result. JSON Patch operations are applied in order and the patch fails
+// as a whole if any of them, including tests, fail.
+func Apply(doc, patch []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("jsonpatch: decoding document: %w", err)
+	}
+	if IsMerge(patch) {
+		p, err := decode(patch)
+		if err != nil {
+			return nil, fmt.Errorf("jsonpatch: decoding merge patch: %w", err)
+		}
+		target = merge(target, p)
+	} else {
+		ops, err := decodeOperations(patch)
+		if err != nil {
+			return nil, err
+		}
+		for i, op := range ops {
+			if target, err = op.apply(target); err != nil {
+				return nil, fmt.Errorf("jsonpatch: operation %d (%s %s): %w", i, op.Op, op.Path, err)
+			}
+		}
+	}
+	return encode(target)
+}
+
+// Paths returns the JSON Pointers of the locations modified by the patch. For
+// JSON Patch these are the paths of each operation, along with the source of
+// move operations. For Merge Patch, each member that is not itself a
+// non-empty object to be merged is reported. Locations nested inside the
+// object or array values written by the patch are not included.
+func Paths(patch []byte) ([]string, error) {
+	if IsMerge(patch) {
+		p, err := decode(patch)
+		if err != nil {
+			return nil, fmt.Errorf("jsonpatch: decoding merge patch: %w", err)
+		}
+		return mergePaths("", p), nil
+	}
+	ops, err := decodeOperations(patch)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, op := range ops {
+		switch op.Op {
+		case OpTest:
+			continue
+		case OpMove:
+			paths = append(paths, op.From)
+		}
+		paths = append(paths, op.Path)
+	}
+	return paths, nil
+}
+
+// Tokens splits a JSON Pointer into its unescaped reference tokens. The
+// empty pointer, which refers to the whole document, has no tokens.
+func Tokens(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("invalid pointer %q", ptr)
+	}
+	toks := strings.Split(ptr[1:], "/")
+	for i, t := range toks {
+		toks[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return toks, nil
+}
+
+func decodeOperations(patch []byte) ([]*Operation, error) {
+	var ops []*Operation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("jsonpatch: decoding patch: %w", err)
+	}
+	for i, op := range ops {
+		if err := op.check(); err != nil {
+			return nil, fmt.Errorf("jsonpatch: operation %d: %w", i, err)
+		}
+	}
+	return ops, nil
+}
+
+func (op *Operation) check() error {
+	if op == nil {
+		return errors.New("missing operation")
+	}
+	switch op.Op {
+	case OpAdd, OpReplace, OpTest:
+		if op.Value == nil {
+			return fmt.Errorf("%s requires a value", op.Op)
+		}
+	case OpMove, OpCopy:
+		if _, err := Tokens(op.From); err != nil {
+			return err
+		}
+	case OpRemove:
+	default:
+		return fmt.Errorf("unknown op %q", op.Op)
+	}
+	_, err := Tokens(op.Path)
+	return err
+}
+
+func (op *Operation) apply(doc any) (any, error) {
+	path, _ := Tokens(op.Path)
+	switch op.Op {
+	case OpAdd:
+		v, err := decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case OpRemove:
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case OpReplace:
+		v, err := decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return replace(doc, path, v)
+	case OpMove:
+		if op.From == op.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, errors.New("cannot move a value into one of its children")
+		}
+		from, _ := Tokens(op.From)
+		doc, v, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case OpCopy:
+		from, _ := Tokens(op.From)
+		v, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, clone(v))
+	case OpTest:
+		v, err := decode(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		cur, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(cur, v) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", op.Op)
+}
+
+// get returns the value referenced by the tokens.
+func get(node any, toks []string) (any, error) {
+	for _, tok := range toks {
+		var err error
+		if node, err = child(node, tok); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// add inserts the value at the location referenced by the tokens, whose
+// parent must exist.
+func add(doc any, toks []string, val any) (any, error) {
+	if len(toks) == 0 {
+		return val, nil
+	}
+	return modify(doc, toks, func(parent any, tok string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			p[tok] = val
+			return p, nil
+		case []any:
+			i := len(p)
+			if tok != "-" {
+				var err error
+				if i, err = index(tok, len(p)+1); err != nil {
+					return nil, err
+				}
+			}
+			return slices.Insert(p, i, val), nil
+		}
+		return nil, fmt.Errorf("cannot add %q to a scalar value", tok)
+	})
+}
+
+// replace sets the value at the location referenced by the tokens, which
+// must already exist.
+func replace(doc any, toks []string, val any) (any, error) {
+	if len(toks) == 0 {
+		return val, nil
+	}
+	return modify(doc, toks, func(parent any, tok string) (any, error) {
+		if _, err := child(parent, tok); err != nil {
+			return nil, err
+		}
+		switch p := parent.(type) {
+		case map[string]any:
+			p[tok] = val
+		case []any:
+			i, _ := index(tok, len(p))
+			p[i] = val
+		}
+		return parent, nil
+	})
+}
+
+// remove deletes the value at the location referenced by the tokens, and
+// returns it.
+func remove(doc any, toks []string) (any, any, error) {
+	if len(toks) == 0 {
+		return nil, nil, errors.New("cannot remove the whole document")
+	}
+	var removed any
+	doc, err := modify(doc, toks, func(parent any, tok string) (any, error) {
+		v, err := child(parent, tok)
+		if err != nil {
+			return nil, err
+		}
+		removed = v
+		switch p := parent.(type) {
+		case map[string]any:
+			delete(p, tok)
+			return p, nil
+		case []any:
+			i, _ := index(tok, len(p))
+			return slices.Delete(p, i, i+1), nil
+		}
+		return parent, nil
+	})
+	return doc, removed, err
+}
+
+// modify walks the node to the parent of the location referenced by the
+// tokens, and replaces the parent with the result of fn. Slices may be
+// reallocated, so each container on the way is updated.
+func modify(node any, toks []string, fn func(parent any, tok string) (any, error)) (any, error) {
+	if len(toks) == 1 {
+		return fn(node, toks[0])
+	}
+	c, err := child(node, toks[0])
+	if err != nil {
+		return nil, err
+	}
+	if c, err = modify(c, toks[1:], fn); err != nil {
+		return nil, err
+	}
+	switch n := node.(type) {
+	case map[string]any:
+		n[toks[0]] = c
+	case []any:
+		i, _ := index(toks[0], len(n))
+		n[i] = c
+	}
+	return node, nil
+}
+
+func child(node any, tok string) (any, error) {
+	switch n := node.(type) {
+	case map[string]any:
+		v, ok := n[tok]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", tok)
+		}
+		return v, nil
+	case []any:
+		i, err := index(tok, len(n))
+		if err != nil {
+			return nil, err
+		}
+		return n[i], nil
+	}
+	return nil, fmt.Errorf("cannot reference %q in a scalar value", tok)
+}
+
+// index parses an array index token, which must be lower than size.
+func index(tok string, size int) (int, error) {
+	if tok == "" || (len(tok) > 1 && tok[0] == '0') || strings.Trim(tok, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	i, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	if i >= size {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+// merge applies a merge patch to the target following RFC 7396.
+func merge(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any)
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = merge(t[k], v)
+	}
+	return t
+}
+
+func mergePaths(base string, patch any) []string {
+	p, ok := patch.(map[string]any)
+	if !ok || (len(p) == 0 && base != "") {
+		return []string{base}
+	}
+	var paths []string
+	for _, k := range sortedKeys(p) {
+		paths = append(paths, mergePaths(pointer(base, k), p[k])...)
+	}
+	return paths
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// pointer appends the escaped reference token to the base pointer.
+func pointer(base, tok string) string {
+	return base + "/" + strings.ReplaceAll(strings.ReplaceAll(tok, "~", "~0"), "/", "~1")
+}
+
+// equal compares two decoded JSON values, treating numbers with the same
+// value as equal regardless of their representation.
+func equal(a, b any) bool {
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if x == y {
+			return true
+		}
+		r1, ok1 := new(big.Rat).SetString(x.String())
+		r2, ok2 := new(big.Rat).SetString(y.String())
+		return ok1 && ok2 && r1.Cmp(r2) == 0
+	}
+	return a == b
+}
+
+func clone(v any) any {
+	switch x := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(x))
+		for k, v := range x {
+			m[k] = clone(v)
+		}
+		return m
+	case []any:
+		s := make([]any, len(x))
+		for i, v := range x {
+			s[i] = clone(v)
+		}
+		return s
+	}
+	return v
+}
+
+func decode(data []byte) (any, error) {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("jsonpatch: encoding: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
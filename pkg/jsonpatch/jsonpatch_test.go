@@ -0,0 +1,206 @@
+package jsonpatch_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/pkg/jsonpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+		err   string
+	}{
+		{
+			name:  "add member",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux"}]`,
+			want:  `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:  "add array element",
+			doc:   `{"foo":["bar","baz"]}`,
+			patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			want:  `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:  "append array element",
+			doc:   `{"foo":{"bar":[1]}}`,
+			patch: `[{"op":"add","path":"/foo/bar/-","value":{"a":null}}]`,
+			want:  `{"foo":{"bar":[1,{"a":null}]}}`,
+		},
+		{
+			name:  "add with missing parent",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			err:   `jsonpatch: operation 0 (add /baz/bat): member "baz" not found`,
+		},
+		{
+			name:  "remove array element",
+			doc:   `{"foo":["bar","qux","baz"]}`,
+			patch: `[{"op":"remove","path":"/foo/1"}]`,
+			want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:  "remove missing",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"remove","path":"/baz"}]`,
+			err:   `member "baz" not found`,
+		},
+		{
+			name:  "replace",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			want:  `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:  "replace missing",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			err:   `member "baz" not found`,
+		},
+		{
+			name:  "move",
+			doc:   `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:  "move array element",
+			doc:   `{"foo":["all","grass","cows","eat"]}`,
+			patch: `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			want:  `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:  "move into child",
+			doc:   `{"foo":{"bar":1}}`,
+			patch: `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`,
+			err:   "cannot move a value into one of its children",
+		},
+		{
+			name:  "copy",
+			doc:   `{"foo":{"bar":[1]}}`,
+			patch: `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"add","path":"/baz/bar/-","value":2}]`,
+			want:  `{"baz":{"bar":[1,2]},"foo":{"bar":[1]}}`,
+		},
+		{
+			name:  "test numbers",
+			doc:   `{"foo":{"bar":10.50}}`,
+			patch: `[{"op":"test","path":"/foo/bar","value":10.5},{"op":"remove","path":"/foo/bar"}]`,
+			want:  `{"foo":{}}`,
+		},
+		{
+			name:  "test failed",
+			doc:   `{"baz":"qux"}`,
+			patch: `[{"op":"test","path":"/baz","value":"bar"}]`,
+			err:   "jsonpatch: operation 0 (test /baz): test failed",
+		},
+		{
+			name:  "escaped pointer",
+			doc:   `{"a/b":{"m~n":1}}`,
+			patch: `[{"op":"replace","path":"/a~1b/m~0n","value":2}]`,
+			want:  `{"a/b":{"m~n":2}}`,
+		},
+		{
+			name:  "replace document",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"replace","path":"","value":{"baz":1}}]`,
+			want:  `{"baz":1}`,
+		},
+		{
+			name:  "invalid index",
+			doc:   `{"foo":[1,2]}`,
+			patch: `[{"op":"remove","path":"/foo/01"}]`,
+			err:   `invalid array index "01"`,
+		},
+		{
+			name:  "index out of range",
+			doc:   `{"foo":[1,2]}`,
+			patch: `[{"op":"add","path":"/foo/3","value":3}]`,
+			err:   "array index 3 out of range",
+		},
+		{
+			name:  "unknown op",
+			doc:   `{}`,
+			patch: `[{"op":"bad","path":"/foo"}]`,
+			err:   `jsonpatch: operation 0: unknown op "bad"`,
+		},
+		{
+			name:  "missing value",
+			doc:   `{}`,
+			patch: `[{"op":"add","path":"/foo"}]`,
+			err:   "add requires a value",
+		},
+		{
+			name:  "invalid pointer",
+			doc:   `{}`,
+			patch: `[{"op":"remove","path":"foo"}]`,
+			err:   `invalid pointer "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := jsonpatch.Apply([]byte(tt.doc), []byte(tt.patch))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(out))
+		})
+	}
+}
+
+func TestApplyMerge(t *testing.T) {
+	doc := `{"title":"Goodbye!","author":{"givenName":"John","familyName":"Doe"},"tags":["example","sample"],"content":"This will be unchanged","price":10.50}`
+	patch := `{"title":"Hello!","phoneNumber":"+01-123-456-7890","author":{"familyName":null},"tags":["example"]}`
+	out, err := jsonpatch.Apply([]byte(doc), []byte(patch))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title":"Hello!","author":{"givenName":"John"},"tags":["example"],"content":"This will be unchanged","phoneNumber":"+01-123-456-7890","price":10.50}`, string(out))
+	assert.Contains(t, string(out), `"price":10.50`)
+
+	out, err = jsonpatch.Apply([]byte(`{"a":"b"}`), []byte(`{"a":{"b":"c","d":null}}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":{"b":"c"}}`, string(out))
+
+	_, err = jsonpatch.Apply([]byte(`{"a":"b"}`), []byte(`{"a":`))
+	assert.ErrorContains(t, err, "jsonpatch: decoding merge patch")
+}
+
+func TestPaths(t *testing.T) {
+	paths, err := jsonpatch.Paths([]byte(`[
+		{"op":"test","path":"/a","value":1},
+		{"op":"move","from":"/b","path":"/c"},
+		{"op":"copy","from":"/d","path":"/e"},
+		{"op":"remove","path":"/f/0"},
+		{"op":"add","path":"/g/-","value":{"h":[{"i":1}],"j":2}}
+	]`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/b", "/c", "/e", "/f/0", "/g/-"}, paths)
+
+	paths, err = jsonpatch.Paths([]byte(`{"b":{"c":1,"d":null,"e":{}},"a/b":[1,{"c":2}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/a~1b", "/b/c", "/b/d", "/b/e"}, paths)
+
+	paths, err = jsonpatch.Paths([]byte(`null`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, paths)
+}
+
+func TestTokens(t *testing.T) {
+	toks, err := jsonpatch.Tokens("/a~1b/m~0n/0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a/b", "m~n", "0"}, toks)
+
+	toks, err = jsonpatch.Tokens("")
+	require.NoError(t, err)
+	assert.Empty(t, toks)
+
+	_, err = jsonpatch.Tokens("a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+// Package au provides the tax region definition for Australia.
+package au
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Australia
+const CountryCode = "AU"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("au", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgIdentityRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeIdentity), // *org.Identity
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.AUD,
+		TaxScheme: tax.CategoryGST,
+		Name: i18n.String{
+			i18n.EN: "Australia",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("ATO - Tax invoices"),
+				URL:   "https://www.ato.gov.au/businesses-and-organisations/gst-excise-and-indirect-taxes/gst/issuing-tax-invoices",
+			},
+		},
+		TimeZone: "Australia/Sydney",
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Australia applies a Goods and Services Tax (GST) of 10% administered by the
+				Australian Taxation Office (ATO). Basic food, most health and education
+				services, and exports are GST-free, while financial supplies and residential
+				rent are input-taxed.
+
+				Businesses are identified by their Australian Business Number (ABN), an 11
+				digit number with a checksum which is also used to identify GST registered
+				suppliers and as the Peppol participant identifier (scheme 0151).
+
+				Tax invoices must include the supplier's identity and ABN, the date, a
+				description of the items, and the GST amount. Invoices with a total of
+				AU$1,000 or more must also include the buyer's identity or ABN. Adjustment
+				notes are used to correct previous tax invoices.
+			`),
+		},
+		Identities: identityDefinitions, // org_identities.go
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+			},
+		},
+		Categories: taxCategories(),
+	}
+}
@@ -0,0 +1,72 @@
+package au
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// buyerIdentityThreshold is the total amount, including GST, from which tax
+// invoices must include the buyer's identity or ABN.
+var buyerIdentityThreshold = num.MakeAmount(1000, 0)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Assert("01", "invoice supplier in Australia must have an ABN tax ID code or identity",
+					is.Func("has ABN", hasABN),
+				),
+			),
+			rules.When(is.Func("above buyer identity threshold", invoiceAboveBuyerIdentityThreshold),
+				rules.Field("customer",
+					rules.Assert("02", "invoice customer is required for tax invoices of AU$1,000 or more", is.Present),
+					rules.Assert("03", "invoice customer name or ABN is required for tax invoices of AU$1,000 or more",
+						is.Func("has name or ABN", hasNameOrABN),
+					),
+				),
+			),
+		),
+	)
+}
+
+func hasABN(value any) bool {
+	party, _ := value.(*org.Party)
+	if party == nil {
+		return false
+	}
+	if party.TaxID != nil && party.TaxID.Code != "" {
+		return true
+	}
+	return org.IdentityForType(party.Identities, IdentityTypeABN) != nil
+}
+
+func hasNameOrABN(value any) bool {
+	party, _ := value.(*org.Party)
+	return party != nil && (party.Name != "" || hasABN(party))
+}
+
+// invoiceAboveBuyerIdentityThreshold checks the invoice's total with tax in
+// Australian dollars, converting from other currencies when an exchange rate
+// is available. Invoices without a rate will always be considered above
+// the threshold.
+func invoiceAboveBuyerIdentityThreshold(value any) bool {
+	inv, ok := value.(*bill.Invoice)
+	if !ok || inv == nil || inv.Totals == nil {
+		return false
+	}
+	total := inv.Totals.TotalWithTax
+	if inv.Currency != currency.AUD {
+		rate := currency.MatchExchangeRate(inv.ExchangeRates, inv.Currency, currency.AUD)
+		if rate == nil {
+			return true
+		}
+		total = rate.Convert(total)
+	}
+	return total.Compare(buyerIdentityThreshold) >= 0
+}
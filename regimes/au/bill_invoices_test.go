@@ -0,0 +1,109 @@
+package au_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/au"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(au.CountryCode),
+		Code:      "0001",
+		IssueDate: cal.MakeDate(2024, 7, 1),
+		Supplier: &org.Party{
+			Name: "Test Supplier Pty Ltd",
+			TaxID: &tax.Identity{
+				Country: "AU",
+				Code:    "51824753556",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(1, 0),
+				Item: &org.Item{
+					Name:  "Test Item",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryGST, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "AUD", inv.Currency.String())
+		assert.Equal(t, "10.00", inv.Totals.Tax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("below threshold without customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(90000, 2)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "990.00", inv.Totals.TotalWithTax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("above threshold without customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-AU-BILL-INVOICE-02]")
+	})
+	t.Run("above threshold with customer ABN", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		inv.Customer = &org.Party{
+			Identities: []*org.Identity{
+				{Type: au.IdentityTypeABN, Code: "53004085616"},
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("above threshold without customer name or ABN", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		inv.Customer = &org.Party{
+			Emails: []*org.Email{{Address: "test@example.com"}},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-AU-BILL-INVOICE-03]")
+	})
+	t.Run("foreign currency converted below threshold", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(50000, 2)
+		inv.Currency = currency.USD
+		inv.ExchangeRates = []*currency.ExchangeRate{
+			{From: currency.USD, To: currency.AUD, Amount: num.MakeAmount(15, 1)},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("foreign currency without rate", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(50000, 2)
+		inv.Currency = currency.USD
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-AU-BILL-INVOICE-02]")
+	})
+	t.Run("missing supplier ABN", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-AU-BILL-INVOICE-01]")
+	})
+}
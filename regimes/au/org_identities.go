@@ -0,0 +1,61 @@
+package au
+
+import (
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// IdentityTypeABN represents the Australian Business Number.
+	IdentityTypeABN cbc.Code = "ABN"
+)
+
+var identityDefinitions = []*cbc.Definition{
+	{
+		Code: IdentityTypeABN,
+		Name: i18n.String{
+			i18n.EN: "Australian Business Number (ABN)",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				The Australian Business Number (ABN) is an 11 digit identifier issued by the
+				Australian Business Register to entities carrying on a business in Australia.
+				It is used to identify suppliers on tax invoices and as the participant
+				identifier in the A-NZ Peppol network under ISO 6523 scheme 0151.
+			`),
+		},
+	},
+}
+
+func normalizeIdentity(id *org.Identity) {
+	if id == nil || id.Type != IdentityTypeABN {
+		return
+	}
+	code := strings.ToUpper(id.Code.String())
+	code = tax.IdentityCodeBadCharsRegexp.ReplaceAllString(code, "")
+	id.Code = cbc.Code(strings.TrimPrefix(code, string(l10n.AU)))
+}
+
+func orgIdentityRules() *rules.Set {
+	return rules.For(new(org.Identity),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				org.IdentityTypeIn(IdentityTypeABN),
+				rules.Field("code",
+					rules.Assert("01", "identity code for type ABN must be valid",
+						is.Func("valid ABN", isValidABNCode),
+					),
+				),
+			),
+		),
+	)
+}
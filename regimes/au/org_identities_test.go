@@ -0,0 +1,26 @@
+package au_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/au"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgIdentityABN(t *testing.T) {
+	ctx := tax.RegimeContext(au.CountryCode)
+	t.Run("normalize and validate", func(t *testing.T) {
+		id := &org.Identity{Type: au.IdentityTypeABN, Code: "51 824 753 556"}
+		norm.Normalize(id, ctx)
+		assert.Equal(t, "51824753556", id.Code.String())
+		assert.NoError(t, rules.Validate(id, ctx))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		id := &org.Identity{Type: au.IdentityTypeABN, Code: "51824753557"}
+		assert.ErrorContains(t, rules.Validate(id, ctx), "[GOBL-AU-ORG-IDENTITY-01]")
+	})
+}
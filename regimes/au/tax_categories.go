@@ -0,0 +1,52 @@
+package au
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxCategories() []*tax.CategoryDef {
+	return []*tax.CategoryDef{
+		// GST
+		{
+			Code: tax.CategoryGST,
+			Name: i18n.String{
+				i18n.EN: "GST",
+			},
+			Title: i18n.String{
+				i18n.EN: "Goods and Services Tax",
+			},
+			Sources: []*cbc.Source{
+				{
+					Title: i18n.String{
+						i18n.EN: "A New Tax System (Goods and Services Tax) Act 1999",
+					},
+					URL: "https://www.legislation.gov.au/C2004A00446/latest/text",
+				},
+			},
+			Retained: false,
+			Keys:     tax.GlobalGSTKeys(),
+			Rates: []*tax.RateDef{
+				{
+					Keys: []cbc.Key{tax.KeyStandard},
+					Rate: tax.RateGeneral,
+					Name: i18n.String{
+						i18n.EN: "General rate",
+					},
+					Description: i18n.String{
+						i18n.EN: "Applies to most taxable supplies of goods and services in Australia, unless they are GST-free or input-taxed.",
+					},
+					Values: []*tax.RateValueDef{
+						{
+							Since:   cal.NewDate(2000, 7, 1),
+							Percent: num.MakePercentage(10, 2),
+						},
+					},
+				},
+			},
+		},
+	}
+}
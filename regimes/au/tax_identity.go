@@ -0,0 +1,61 @@
+package au
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// GST registered suppliers are identified by their ABN.
+//
+// Reference: https://abr.business.gov.au/Help/AbnFormat
+
+var (
+	abnRegexp  = regexp.MustCompile(`^\d{11}$`)
+	abnWeights = []int{10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
+)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Australian Business Number (ABN)",
+					is.Func("valid", isValidABNCode),
+				),
+			),
+		),
+	)
+}
+
+func isValidABNCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	return validateABN(code) == nil
+}
+
+// validateABN subtracts 1 from the first digit and checks that the weighted
+// sum of all the digits is divisible by 89.
+func validateABN(code cbc.Code) error {
+	val := code.String()
+	if !abnRegexp.MatchString(val) {
+		return errors.New("invalid format")
+	}
+	sum := 0
+	for i, w := range abnWeights {
+		d := int(val[i] - '0')
+		if i == 0 {
+			d--
+		}
+		sum += d * w
+	}
+	if sum%89 != 0 {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
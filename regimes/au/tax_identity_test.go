@@ -0,0 +1,98 @@
+package au_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/au"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips AU prefix and spaces",
+			inputCode:    "AU 51 824 753 556",
+			expectedCode: "51824753556",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "53004085616",
+			expectedCode: "53004085616",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "AU", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "ATO example",
+			inputCode: "51824753556",
+		},
+		{
+			name:      "company",
+			inputCode: "53004085616",
+		},
+		{
+			name:      "empty",
+			inputCode: "",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "51824753557",
+			expectedErr: "[GOBL-AU-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "too short",
+			inputCode:   "5182475355",
+			expectedErr: "[GOBL-AU-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "letters",
+			inputCode:   "5182475355A",
+			expectedErr: "[GOBL-AU-TAX-IDENTITY-01]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "AU", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package cl
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+var correctionDefinitions = []*tax.CorrectionDefinition{
+	{
+		Schema: bill.ShortSchemaInvoice,
+		Types: []cbc.Key{
+			bill.InvoiceTypeCreditNote,
+			bill.InvoiceTypeDebitNote,
+		},
+		Extensions: []cbc.Key{
+			ExtKeyDTERefCode,
+		},
+		ReasonRequired: true,
+	},
+}
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+					rules.Field("code",
+						rules.Assert("02", "invoice supplier tax ID code is required", is.Present),
+					),
+				),
+			),
+			// Receipts (boletas) may be issued to anonymous consumers.
+			rules.When(is.Func("not simplified", isNotSimplifiedInvoice),
+				rules.Field("customer",
+					rules.Assert("03", "invoice customer is required", is.Present),
+					rules.Field("tax_id",
+						rules.Assert("04", "invoice customer tax ID is required", is.Present),
+					),
+				),
+			),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeStandard, bill.InvoiceTypeCreditNote, bill.InvoiceTypeDebitNote),
+				rules.Field("tax",
+					rules.Assert("05", "invoice tax is required", is.Present),
+					rules.Field("ext",
+						rules.Assert("06",
+							fmt.Sprintf("invoice tax requires '%s' extension", ExtKeyDTEType),
+							tax.ExtensionsRequire(ExtKeyDTEType),
+						),
+						rules.Assert("07",
+							fmt.Sprintf("invoice tax '%s' extension must be a valid DTE type", ExtKeyDTEType),
+							tax.ExtensionHasValidCode(ExtKeyDTEType),
+						),
+					),
+				),
+			),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote, bill.InvoiceTypeDebitNote),
+				rules.Field("preceding",
+					rules.Assert("08", "preceding documents are required for credit and debit notes", is.Present),
+					rules.Each(
+						rules.Field("ext",
+							rules.Assert("09",
+								fmt.Sprintf("preceding document requires '%s' extension", ExtKeyDTERefCode),
+								tax.ExtensionsRequire(ExtKeyDTERefCode),
+							),
+							rules.Assert("10",
+								fmt.Sprintf("preceding document '%s' extension must be a valid reference code", ExtKeyDTERefCode),
+								tax.ExtensionHasValidCode(ExtKeyDTERefCode),
+							),
+						),
+						rules.Field("reason",
+							rules.Assert("11", "preceding document reason is required", is.Present),
+						),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isNotSimplifiedInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && !inv.HasTags(tax.TagSimplified)
+}
@@ -0,0 +1,189 @@
+package cl_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/cl"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(cl.CountryCode),
+		Code:      "1001",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Proveedor SpA",
+			TaxID: &tax.Identity{
+				Country: "CL",
+				Code:    "760864285",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Cliente Ltda.",
+			TaxID: &tax.Identity{
+				Country: "CL",
+				Code:    "967902403",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Servicio de consultoría",
+					Price: num.NewAmount(10000, 0),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceDTETypeScenarios(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(inv *bill.Invoice)
+		code  cbc.Code
+	}{
+		{
+			name:  "standard",
+			setup: func(_ *bill.Invoice) {},
+			code:  "33",
+		},
+		{
+			name: "exempt",
+			setup: func(inv *bill.Invoice) {
+				inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyExempt}
+			},
+			code: "34",
+		},
+		{
+			name: "receipt",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tax.TagSimplified)
+				inv.Customer = nil
+			},
+			code: "39",
+		},
+		{
+			name: "exempt receipt",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tax.TagSimplified)
+				inv.Customer = nil
+				inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyExempt}
+			},
+			code: "41",
+		},
+		{
+			name: "purchase invoice",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tax.TagSelfBilled)
+			},
+			code: "46",
+		},
+		{
+			name: "export",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tax.TagExport)
+				inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyExport}
+			},
+			code: "110",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			tt.setup(inv)
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			assert.Equal(t, tt.code, inv.Tax.Ext.Get(cl.ExtKeyDTEType))
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "CLP", inv.Currency.String())
+		assert.Equal(t, "19000", inv.Totals.Tax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-CL-BILL-INVOICE-01]")
+	})
+	t.Run("missing customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-CL-BILL-INVOICE-03]")
+	})
+	t.Run("missing customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-CL-BILL-INVOICE-04]")
+	})
+	t.Run("invalid DTE type", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		inv.Tax.Ext = inv.Tax.Ext.Set(cl.ExtKeyDTEType, "99")
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-CL-BILL-INVOICE-07]")
+	})
+}
+
+func TestInvoiceCorrection(t *testing.T) {
+	original := func(t *testing.T) *bill.Invoice {
+		t.Helper()
+		inv := validInvoice()
+		inv.Series = "F"
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		return inv
+	}
+
+	t.Run("credit note", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(
+			bill.Credit,
+			bill.WithReason("Devolución de mercadería"),
+			bill.WithExtension(cl.ExtKeyDTERefCode, "1"),
+		)
+		require.NoError(t, err)
+		require.NoError(t, cn.Calculate())
+		require.NoError(t, rules.Validate(cn))
+		assert.Equal(t, "61", cn.Tax.Ext.Get(cl.ExtKeyDTEType).String())
+		require.Len(t, cn.Preceding, 1)
+		assert.Equal(t, "1", cn.Preceding[0].Ext.Get(cl.ExtKeyDTERefCode).String())
+	})
+	t.Run("missing reason", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(
+			bill.Credit,
+			bill.WithExtension(cl.ExtKeyDTERefCode, "3"),
+		)
+		assert.ErrorContains(t, err, "reason")
+	})
+	t.Run("missing reference code", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(bill.Debit, bill.WithReason("Intereses"))
+		if err == nil {
+			require.NoError(t, cn.Calculate())
+			err = rules.Validate(cn)
+		}
+		assert.ErrorContains(t, err, cl.ExtKeyDTERefCode.String())
+	})
+}
@@ -0,0 +1,76 @@
+// Package cl provides the tax regime definition for Chile.
+package cl
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Chile.
+const CountryCode = "CL"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("cl", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.CLP,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Chile",
+			i18n.ES: "Chile",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Chile's tax system is administered by the SII (Servicio de Impuestos
+				Internos). IVA (Impuesto al Valor Agregado) is charged at a single
+				general rate on the sale of goods and most services, with specific
+				exemptions defined in the DL 825.
+
+				Taxpayers are identified by their RUT (Rol Único Tributario), made up
+				of up to 8 digits followed by a modulus 11 check digit that may be
+				the letter K, commonly written as "76.086.428-5".
+
+				Electronic tax documents (DTE, Documentos Tributarios Electrónicos)
+				are mandatory for all taxpayers. Each document declares its type in
+				the ~cl-dte-type~ extension, which GOBL determines automatically:
+				~33~ for invoices, ~34~ when all lines are exempt, ~39~ and ~41~ for
+				receipts (boletas) issued with the ~simplified~ tag, ~46~ for
+				self-billed purchase invoices, ~110~ to ~112~ for exports, and ~61~
+				and ~56~ for credit and debit notes. Corrections must reference the
+				original document with the ~cl-dte-ref-code~ extension and a reason.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SII - Factura Electrónica"),
+				URL:   "https://www.sii.cl/factura_electronica/",
+			},
+			{
+				Title: i18n.NewString("SII - Formato de Documentos Tributarios Electrónicos"),
+				URL:   "https://www.sii.cl/factura_electronica/formato_dte.pdf",
+			},
+		},
+		TimeZone:    "America/Santiago",
+		Tags:        []*tax.TagSet{invoiceTags},
+		Extensions:  extensions,
+		Scenarios:   []*tax.ScenarioSet{invoiceScenarios},
+		Categories:  taxCategories,
+		Corrections: correctionDefinitions,
+	}
+}
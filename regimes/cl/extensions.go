@@ -0,0 +1,144 @@
+package cl
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyDTEType    cbc.Key = "cl-dte-type"
+	ExtKeyDTERefCode cbc.Key = "cl-dte-ref-code"
+)
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyDTEType,
+		Name: i18n.String{
+			i18n.EN: "DTE Type",
+			i18n.ES: "Tipo de DTE",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Type of electronic tax document (DTE) as defined by the SII, set
+				automatically from the invoice type and tags.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "33",
+				Name: i18n.String{
+					i18n.EN: "Electronic invoice",
+					i18n.ES: "Factura electrónica",
+				},
+			},
+			{
+				Code: "34",
+				Name: i18n.String{
+					i18n.EN: "Electronic exempt invoice",
+					i18n.ES: "Factura no afecta o exenta electrónica",
+				},
+			},
+			{
+				Code: "39",
+				Name: i18n.String{
+					i18n.EN: "Electronic receipt",
+					i18n.ES: "Boleta electrónica",
+				},
+			},
+			{
+				Code: "41",
+				Name: i18n.String{
+					i18n.EN: "Electronic exempt receipt",
+					i18n.ES: "Boleta exenta electrónica",
+				},
+			},
+			{
+				Code: "46",
+				Name: i18n.String{
+					i18n.EN: "Electronic purchase invoice",
+					i18n.ES: "Factura de compra electrónica",
+				},
+			},
+			{
+				Code: "52",
+				Name: i18n.String{
+					i18n.EN: "Electronic dispatch guide",
+					i18n.ES: "Guía de despacho electrónica",
+				},
+			},
+			{
+				Code: "56",
+				Name: i18n.String{
+					i18n.EN: "Electronic debit note",
+					i18n.ES: "Nota de débito electrónica",
+				},
+			},
+			{
+				Code: "61",
+				Name: i18n.String{
+					i18n.EN: "Electronic credit note",
+					i18n.ES: "Nota de crédito electrónica",
+				},
+			},
+			{
+				Code: "110",
+				Name: i18n.String{
+					i18n.EN: "Electronic export invoice",
+					i18n.ES: "Factura de exportación electrónica",
+				},
+			},
+			{
+				Code: "111",
+				Name: i18n.String{
+					i18n.EN: "Electronic export debit note",
+					i18n.ES: "Nota de débito de exportación electrónica",
+				},
+			},
+			{
+				Code: "112",
+				Name: i18n.String{
+					i18n.EN: "Electronic export credit note",
+					i18n.ES: "Nota de crédito de exportación electrónica",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyDTERefCode,
+		Name: i18n.String{
+			i18n.EN: "Reference Code",
+			i18n.ES: "Código de Referencia",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Reason a credit or debit note references the preceding document,
+				set on each preceding document reference.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "1",
+				Name: i18n.String{
+					i18n.EN: "Cancels the referenced document",
+					i18n.ES: "Anula documento de referencia",
+				},
+			},
+			{
+				Code: "2",
+				Name: i18n.String{
+					i18n.EN: "Corrects the text of the referenced document",
+					i18n.ES: "Corrige texto del documento de referencia",
+				},
+			},
+			{
+				Code: "3",
+				Name: i18n.String{
+					i18n.EN: "Corrects amounts",
+					i18n.ES: "Corrige montos",
+				},
+			},
+		},
+	},
+}
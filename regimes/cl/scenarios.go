@@ -0,0 +1,125 @@
+package cl
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceTags = &tax.TagSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*cbc.Definition{
+		{
+			Key: tax.TagExport,
+			Name: i18n.String{
+				i18n.EN: "Export",
+				i18n.ES: "Exportación",
+			},
+		},
+	},
+}
+
+// Scenarios are evaluated in order, so more specific cases appear after
+// the general ones to override the DTE type.
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*tax.Scenario{
+		// ** Invoices **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "33",
+			}),
+		},
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Filter: isExemptInvoice,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "34",
+			}),
+		},
+		// ** Receipts **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:  []cbc.Key{tax.TagSimplified},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "39",
+			}),
+		},
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:   []cbc.Key{tax.TagSimplified},
+			Filter: isExemptInvoice,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "41",
+			}),
+		},
+		// ** Purchase invoices **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:  []cbc.Key{tax.TagSelfBilled},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "46",
+			}),
+		},
+		// ** Exports **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:  []cbc.Key{tax.TagExport},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "110",
+			}),
+		},
+		// ** Corrections **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "61",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeDebitNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "56",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Tags:  []cbc.Key{tax.TagExport},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "112",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeDebitNote},
+			Tags:  []cbc.Key{tax.TagExport},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDTEType: "111",
+			}),
+		},
+	},
+}
+
+// isExemptInvoice is true when none of the invoice lines are subject to
+// IVA, in which case an exempt document type must be issued.
+func isExemptInvoice(doc any) bool {
+	inv, ok := doc.(*bill.Invoice)
+	if !ok || len(inv.Lines) == 0 {
+		return false
+	}
+	for _, line := range inv.Lines {
+		if line == nil {
+			continue
+		}
+		for _, combo := range line.Taxes {
+			if combo == nil || combo.Category != tax.CategoryVAT {
+				continue
+			}
+			if !combo.Key.In(tax.KeyExempt, tax.KeyExport, tax.KeyOutsideScope) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package cl
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// IVA
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.ES: "IVA",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.ES: "Impuesto al Valor Agregado",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SII - Impuesto al Valor Agregado"),
+				URL:   "https://www.sii.cl/preguntas_frecuentes/iva/arbol_iva_2349.htm",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.ES: "Tasa General",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2003, 10, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+		},
+	},
+}
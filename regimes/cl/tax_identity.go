@@ -0,0 +1,61 @@
+package cl
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// RUT codes are normalized to the digits followed by the check digit,
+// without dots or dash.
+var rutRegexp = regexp.MustCompile(`^\d{7,8}[0-9K]$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Chilean tax identity code",
+					is.Func("valid RUT", isValidRUT),
+				),
+			),
+		),
+	)
+}
+
+func isValidRUT(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !rutRegexp.MatchString(val) {
+		return false
+	}
+	l := len(val)
+	return rutCheckDigit(val[:l-1]) == val[l-1]
+}
+
+// rutCheckDigit calculates the modulus 11 check digit using weights 2 to
+// 7 from the rightmost digit, where 10 is represented by K.
+func rutCheckDigit(val string) byte {
+	sum := 0
+	w := 2
+	for i := len(val) - 1; i >= 0; i-- {
+		sum += int(val[i]-'0') * w
+		w++
+		if w > 7 {
+			w = 2
+		}
+	}
+	switch r := 11 - sum%11; r {
+	case 11:
+		return '0'
+	case 10:
+		return 'K'
+	default:
+		return byte('0' + r)
+	}
+}
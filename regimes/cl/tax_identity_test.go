@@ -0,0 +1,112 @@
+package cl_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/cl"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips dots and hyphen",
+			inputCode:    "76.086.428-5",
+			expectedCode: "760864285",
+		},
+		{
+			name:         "upper case check digit",
+			inputCode:    "60.803.000-k",
+			expectedCode: "60803000K",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "760864285",
+			expectedCode: "760864285",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "CL", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "valid",
+			inputCode: "760864285",
+		},
+		{
+			name:      "valid 2",
+			inputCode: "967902403",
+		},
+		{
+			name:      "valid K",
+			inputCode: "60803000K",
+		},
+		{
+			name:      "valid 7 digits",
+			inputCode: "76543216",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "760864286",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad K checksum",
+			inputCode:   "76086428K",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "123456",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too long",
+			inputCode:   "1234567890",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "CL", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package cz provides the tax regime definition for Czechia.
+package cz
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Czechia.
+const CountryCode = "CZ"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("cz", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.CZK,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Czechia",
+			i18n.CS: "Česko",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Czechia's VAT (Daň z přidané hodnoty, DPH) is administered by the
+				Financial Administration (Finanční správa) under Act No. 235/2004 Coll.
+				on Value Added Tax. Since 2024 a single reduced rate applies alongside
+				the general rate.
+
+				VAT payers are identified by their DIČ (daňové identifikační číslo),
+				which consists of the CZ prefix followed by the 8 digit company
+				identification number (IČO) for legal entities, or the 9 or 10 digit
+				birth number (rodné číslo) for individuals.
+
+				Domestic reverse charge (přenesení daňové povinnosti) under § 92a
+				applies to specific supplies such as construction work, scrap metal,
+				and emission allowances, in which case the customer accounts for the
+				tax and the invoice must say so.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Zákon o dani z přidané hodnoty (235/2004 Sb.)"),
+				URL:   "https://www.zakonyprolidi.cz/cs/2004-235",
+			},
+		},
+		TimeZone: "Europe/Prague",
+		Scenarios: []*tax.ScenarioSet{
+			invoiceScenarios,
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package cz
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: bill.ReverseChargeScenarios(
+		CountryCode,
+		"Reverse charge / Daň odvede zákazník.",
+		"Daň odvede zákazník podle § 92a zákona o DPH.",
+	),
+}
@@ -0,0 +1,113 @@
+package cz
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.CS: "DPH",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.CS: "Daň z přidané hodnoty",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "Financial Administration - VAT rates",
+					i18n.CS: "Finanční správa - Sazby DPH",
+				},
+				URL: "https://www.financnisprava.cz/cs/dane/dane/dan-z-pridane-hodnoty",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.CS: "Základní sazba",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2013, 1, 1),
+						Percent: num.MakePercentage(210, 3),
+					},
+					{
+						Since:   cal.NewDate(2010, 1, 1),
+						Percent: num.MakePercentage(200, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 5, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.CS: "Snížená sazba",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2024, 1, 1),
+						Percent: num.MakePercentage(120, 3),
+					},
+					{
+						Since:   cal.NewDate(2013, 1, 1),
+						Percent: num.MakePercentage(150, 3),
+					},
+					{
+						Since:   cal.NewDate(2012, 1, 1),
+						Percent: num.MakePercentage(140, 3),
+					},
+					{
+						Since:   cal.NewDate(2010, 1, 1),
+						Percent: num.MakePercentage(100, 3),
+					},
+					{
+						Since:   cal.NewDate(2008, 1, 1),
+						Percent: num.MakePercentage(90, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 5, 1),
+						Percent: num.MakePercentage(50, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateSuperReduced,
+				Name: i18n.String{
+					i18n.EN: "Second Reduced Rate",
+					i18n.CS: "Druhá snížená sazba",
+				},
+				Description: i18n.String{
+					i18n.EN: "Merged into the single reduced rate from 2024.",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2015, 1, 1),
+						Until:   cal.NewDate(2023, 12, 31),
+						Percent: num.MakePercentage(100, 3),
+					},
+				},
+			},
+		},
+	},
+}
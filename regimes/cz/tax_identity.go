@@ -0,0 +1,84 @@
+package cz
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Tax identity codes (DIČ) are formed from the company identification
+// number (IČO) for legal entities, or the birth number (rodné číslo)
+// for individuals.
+var taxCodeRegexp = regexp.MustCompile(`^\d{8,10}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Czech tax identity code",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+			),
+		),
+	)
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !taxCodeRegexp.MatchString(val) {
+		return false
+	}
+	switch len(val) {
+	case 8:
+		return validateLegalEntityCode(val)
+	case 9:
+		// Birth numbers issued before 1954 have no check digit.
+		return true
+	default:
+		return validateBirthNumber(val)
+	}
+}
+
+// validateLegalEntityCode checks the IČO using weights 8 to 2 over the
+// first seven digits with a modulus 11 check digit.
+func validateLegalEntityCode(val string) bool {
+	if val[0] == '9' {
+		// reserved for non-residents
+		return false
+	}
+	sum := 0
+	for i := 0; i < 7; i++ {
+		sum += int(val[i]-'0') * (8 - i)
+	}
+	check := 11 - sum%11
+	switch check {
+	case 10:
+		check = 0
+	case 11:
+		check = 1
+	}
+	return check == int(val[7]-'0')
+}
+
+// validateBirthNumber checks a 10 digit birth number is divisible by 11,
+// allowing for the legacy case where the remainder of the first nine
+// digits is 10 and the check digit 0.
+func validateBirthNumber(val string) bool {
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false
+	}
+	if n%11 == 0 {
+		return true
+	}
+	base := n / 10
+	return base%11 == 10 && val[9] == '0'
+}
@@ -0,0 +1,121 @@
+package cz_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/cz"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips CZ prefix and spaces",
+			inputCode:    "CZ 251 23 891",
+			expectedCode: "25123891",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "7103192745",
+			expectedCode: "7103192745",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "CZ", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "legal entity",
+			inputCode: "25123891",
+		},
+		{
+			name:      "legal entity check 1",
+			inputCode: "00177041",
+		},
+		{
+			name:      "legal entity 2",
+			inputCode: "27082440",
+		},
+		{
+			name:      "birth number",
+			inputCode: "7103192745",
+		},
+		{
+			name:      "legacy birth number",
+			inputCode: "535127123",
+		},
+		{
+			name:        "bad legal entity checksum",
+			inputCode:   "25123890",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "non-resident prefix",
+			inputCode:   "91234567",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad birth number",
+			inputCode:   "7103192746",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "1234567",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too long",
+			inputCode:   "12345678901",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "letters",
+			inputCode:   "2512389A",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "CZ", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
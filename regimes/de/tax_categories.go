@@ -67,6 +67,7 @@ var taxCategories = []*tax.CategoryDef{
 					},
 					{
 						Since:   cal.NewDate(2020, 7, 1), // COVID temporary measures
+						Until:   cal.NewDate(2020, 12, 31),
 						Percent: num.MakePercentage(16, 2),
 					},
 					{
@@ -101,6 +102,7 @@ var taxCategories = []*tax.CategoryDef{
 					},
 					{
 						Since:   cal.NewDate(2020, 7, 1), // COVID temporary measures
+						Until:   cal.NewDate(2020, 12, 31),
 						Percent: num.MakePercentage(5, 2),
 					},
 					{
@@ -0,0 +1,177 @@
+package eg_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/eg"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(eg.CountryCode),
+		Code:      "INV-001",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Supplier S.A.E.",
+			TaxID: &tax.Identity{
+				Country: "EG",
+				Code:    "100324932",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Customer S.A.E.",
+			TaxID: &tax.Identity{
+				Country: "EG",
+				Code:    "200123456",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Mobile phone",
+					Price: num.NewAmount(100000, 2),
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						eg.ExtKeyETAItemType: "GS1",
+						eg.ExtKeyETAItemCode: "6224000123456",
+					}),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2024, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "1400.00",
+		},
+		{
+			name: "general before July 2017",
+			date: cal.MakeDate(2017, 1, 1),
+			rate: tax.RateGeneral,
+			tax:  "1300.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestItemCoding(t *testing.T) {
+	tests := []struct {
+		name string
+		ext  cbc.CodeMap
+		err  string
+	}{
+		{
+			name: "EGS",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "EGS", eg.ExtKeyETAItemCode: "EG-100324932-PHONE01"},
+		},
+		{
+			name: "no coding",
+			ext:  cbc.CodeMap{},
+		},
+		{
+			name: "invalid type",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "UPC", eg.ExtKeyETAItemCode: "6224000123456"},
+			err:  "[GOBL-EG-ORG-ITEM-01]",
+		},
+		{
+			name: "invalid code",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "GS1", eg.ExtKeyETAItemCode: "ABC"},
+			err:  "[GOBL-EG-ORG-ITEM-02]",
+		},
+		{
+			name: "missing code",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "GS1"},
+			err:  "[GOBL-EG-ORG-ITEM-03]",
+		},
+		{
+			name: "EGS code with GS1 type",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "GS1", eg.ExtKeyETAItemCode: "EG-100324932-PHONE01"},
+			err:  "[GOBL-EG-ORG-ITEM-04]",
+		},
+		{
+			name: "GTIN with EGS type",
+			ext:  cbc.CodeMap{eg.ExtKeyETAItemType: "EGS", eg.ExtKeyETAItemCode: "6224000123456"},
+			err:  "[GOBL-EG-ORG-ITEM-04]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.Lines[0].Item.Ext = tax.ExtensionsOf(tt.ext)
+			require.NoError(t, inv.Calculate())
+			err := rules.Validate(inv)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "EGP", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("table tax", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes = append(inv.Lines[0].Taxes, &tax.Combo{
+			Category: eg.TaxCategoryTableTax,
+			Percent:  num.NewPercentage(80, 3),
+		})
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "2200.00", inv.Totals.Tax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EG-BILL-INVOICE-01]")
+	})
+	t.Run("credit note without preceding", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Type = bill.InvoiceTypeCreditNote
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-EG-BILL-INVOICE-03]")
+	})
+}
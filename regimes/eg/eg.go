@@ -0,0 +1,82 @@
+// Package eg provides the tax regime definition for Egypt.
+package eg
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Egypt.
+const CountryCode = "EG"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("eg", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgItemRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.EGP,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Egypt",
+			i18n.AR: "مصر",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Egypt's tax system is administered by the Egyptian Tax Authority
+				(ETA). VAT was introduced by Law No. 67 of 2016, replacing the
+				general sales tax, and is charged at a general rate on most goods
+				and services. Goods and services listed in the schedule (table)
+				annexed to the law are subject to the table tax, either instead
+				of or in addition to VAT, provided in GOBL with the ~TBL~ category
+				and the percent defined for each item.
+
+				Taxpayers are identified by their 9 digit tax registration number.
+
+				Electronic invoices must be submitted to the ETA e-invoicing
+				system, where every item is coded with either a GS1 barcode (GTIN)
+				or an internal EGS code registered with the ETA. The coding system
+				and code are set on each item with the ~eg-eta-item-type~ and
+				~eg-eta-item-code~ extensions.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("ETA - E-Invoicing SDK"),
+				URL:   "https://sdk.invoicing.eta.gov.eg/",
+			},
+			{
+				Title: i18n.NewString("ETA - Value Added Tax Law No. 67 of 2016"),
+				URL:   "https://www.eta.gov.eg/ar/content/vat-law",
+			},
+		},
+		TimeZone:   "Africa/Cairo",
+		Extensions: extensions,
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package eg
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyETAItemType cbc.Key = "eg-eta-item-type"
+	ExtKeyETAItemCode cbc.Key = "eg-eta-item-code"
+)
+
+// ETA item coding systems
+const (
+	ETAItemTypeGS1 cbc.Code = "GS1"
+	ETAItemTypeEGS cbc.Code = "EGS"
+)
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyETAItemType,
+		Name: i18n.String{
+			i18n.EN: "ETA Item Coding System",
+			i18n.AR: "نوع كود الصنف",
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: ETAItemTypeGS1,
+				Name: i18n.String{
+					i18n.EN: "GS1 barcode (GTIN)",
+				},
+			},
+			{
+				Code: ETAItemTypeEGS,
+				Name: i18n.String{
+					i18n.EN: "Egyptian internal code (EGS)",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyETAItemCode,
+		Name: i18n.String{
+			i18n.EN: "ETA Item Code",
+			i18n.AR: "كود الصنف",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Item code registered with the ETA, either an 8 to 14 digit GTIN
+				for GS1 items, or an EGS code made up of ~EG-~, the issuer's tax
+				registration number, and the internal code, for example
+				~EG-100324932-1234~.
+			`),
+		},
+		Pattern: `^(\d{8,14}|EG-\d{9}-[A-Za-z0-9_-]+)$`,
+	},
+}
@@ -0,0 +1,57 @@
+package eg
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+var (
+	gs1CodeRegexp = regexp.MustCompile(`^\d{8,14}$`)
+	egsCodeRegexp = regexp.MustCompile(`^EG-\d{9}-`)
+)
+
+func orgItemRules() *rules.Set {
+	return rules.For(new(org.Item),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("ext",
+				rules.Assert("01",
+					fmt.Sprintf("item '%s' extension must be a valid coding system", ExtKeyETAItemType),
+					tax.ExtensionHasValidCode(ExtKeyETAItemType),
+				),
+				rules.Assert("02",
+					fmt.Sprintf("item '%s' extension must be a valid item code", ExtKeyETAItemCode),
+					tax.ExtensionHasValidCode(ExtKeyETAItemCode),
+				),
+				rules.Assert("03",
+					fmt.Sprintf("item '%s' and '%s' extensions must be provided together", ExtKeyETAItemType, ExtKeyETAItemCode),
+					tax.ExtensionsRequireAllOrNone(ExtKeyETAItemType, ExtKeyETAItemCode),
+				),
+				rules.Assert("04",
+					fmt.Sprintf("item '%s' extension must match the coding system", ExtKeyETAItemCode),
+					is.Func("code matches coding system", itemCodeMatchesType),
+				),
+			),
+		),
+	)
+}
+
+func itemCodeMatchesType(val any) bool {
+	ext, ok := tax.ExtensionsFromValue(val)
+	if !ok {
+		return true
+	}
+	code := ext.Get(ExtKeyETAItemCode).String()
+	switch ext.Get(ExtKeyETAItemType) {
+	case ETAItemTypeGS1:
+		return gs1CodeRegexp.MatchString(code)
+	case ETAItemTypeEGS:
+		return egsCodeRegexp.MatchString(code)
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package eg
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/tax"
+)
+
+// Local tax categories.
+const (
+	TaxCategoryTableTax cbc.Code = "TBL"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.AR: "ض.ق.م",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.AR: "ضريبة القيمة المضافة",
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.AR: "السعر العام",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2017, 7, 1),
+						Percent: num.MakePercentage(140, 3),
+					},
+					{
+						Since:   cal.NewDate(2016, 9, 8),
+						Percent: num.MakePercentage(130, 3),
+					},
+				},
+			},
+		},
+	},
+	//
+	// Table Tax
+	//
+	{
+		Code: TaxCategoryTableTax,
+		Name: i18n.String{
+			i18n.EN: "Table Tax",
+			i18n.AR: "ضريبة الجدول",
+		},
+		Title: i18n.String{
+			i18n.EN: "Schedule Table Tax",
+			i18n.AR: "ضريبة الجدول",
+		},
+		Description: &i18n.String{
+			i18n.EN: here.Doc(`
+				Tax applied to the goods and services listed in the schedule
+				annexed to the VAT law, such as tobacco, alcohol, petroleum
+				products and telecommunications. The percent varies by item
+				and must be provided.
+			`),
+		},
+		Retained: false,
+		Rates:    []*tax.RateDef{},
+	},
+}
@@ -0,0 +1,23 @@
+package eg
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCodeRegexp = regexp.MustCompile(`^\d{9}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Egyptian tax registration number",
+					is.MatchesRegexp(taxCodeRegexp),
+				),
+			),
+		),
+	)
+}
@@ -0,0 +1,90 @@
+package eg_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/eg"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips hyphens",
+			inputCode:    "100-324-932",
+			expectedCode: "100324932",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "100324932",
+			expectedCode: "100324932",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "EG", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "valid",
+			inputCode: "100324932",
+		},
+		{
+			name:        "too short",
+			inputCode:   "10032493",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too long",
+			inputCode:   "1003249321",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "letters",
+			inputCode:   "10032493A",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "EG", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package es
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Withholding certificates in Spain justify the retentions declared by the
+// withholding agent in the annual summaries: model 190 for IRPF applied to
+// residents, and model 296 for IRNR applied to non-residents.
+func billCertificateRules() *rules.Set {
+	return rules.For(new(bill.Certificate),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("period",
+				rules.Assert("01", "certificate period must be within a single calendar year",
+					cal.PeriodInYear(),
+				),
+			),
+			rules.Field("customer",
+				rules.Field("tax_id",
+					rules.Field("country",
+						rules.Assert("02", "certificate customer tax ID must be Spanish",
+							is.In(l10n.TaxCountryCode(CountryCode)),
+						),
+					),
+				),
+			),
+			rules.Field("tax",
+				rules.Assert("03", "certificate cannot combine IRPF and IRNR retentions",
+					is.Func("single summary", certificateTaxSingleSummary),
+				),
+			),
+		),
+	)
+}
+
+// certificateTaxSingleSummary checks that resident and non-resident
+// retentions, which are declared in different summaries, are not combined.
+func certificateTaxSingleSummary(val any) bool {
+	t, ok := val.(*tax.Total)
+	if !ok || t == nil {
+		return true
+	}
+	return t.Category(TaxCategoryIRPF) == nil || t.Category(TaxCategoryIRNR) == nil
+}
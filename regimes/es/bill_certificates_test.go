@@ -0,0 +1,140 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) *bill.Certificate {
+	t.Helper()
+	return &bill.Certificate{
+		Regime:    tax.WithRegime("ES"),
+		Code:      "CERT-001",
+		IssueDate: cal.MakeDate(2025, 1, 15),
+		Period: cal.Period{
+			Start: cal.MakeDate(2024, 1, 1),
+			End:   cal.MakeDate(2024, 12, 31),
+		},
+		Supplier: &org.Party{
+			Name: "Test Supplier",
+			TaxID: &tax.Identity{
+				Country: "ES",
+				Code:    "54387763P",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Test Customer",
+			TaxID: &tax.Identity{
+				Country: "ES",
+				Code:    "B98602642",
+			},
+		},
+		Lines: []*bill.CertificateLine{
+			{
+				Document: &org.DocumentRef{
+					Code:      "001",
+					IssueDate: cal.NewDate(2024, 2, 1),
+				},
+				Tax: testRetainedTotal("IRPF"),
+			},
+		},
+	}
+}
+
+func testRetainedTotal(cat cbc.Code) *tax.Total {
+	return &tax.Total{
+		Categories: []*tax.CategoryTotal{
+			{
+				Code:     cat,
+				Retained: true,
+				Rates: []*tax.RateTotal{
+					{
+						Base:    num.MakeAmount(100000, 2),
+						Percent: num.NewPercentage(15, 2),
+						Amount:  num.MakeAmount(15000, 2),
+					},
+				},
+				Amount: num.MakeAmount(15000, 2),
+			},
+		},
+	}
+}
+
+func TestCertificateValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(crt *bill.Certificate)
+		err  string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "period across years",
+			edit: func(crt *bill.Certificate) {
+				crt.Period.Start = cal.MakeDate(2023, 7, 1)
+			},
+			err: "[GOBL-ES-BILL-CERTIFICATE-01] ($.period) certificate period must be within a single calendar year",
+		},
+		{
+			name: "foreign withholding agent",
+			edit: func(crt *bill.Certificate) {
+				crt.Customer.TaxID = &tax.Identity{Country: "PT", Code: "545259045"}
+			},
+			err: "[GOBL-ES-BILL-CERTIFICATE-02] ($.customer.tax_id.country) certificate customer tax ID must be Spanish",
+		},
+		{
+			name: "non-resident retentions",
+			edit: func(crt *bill.Certificate) {
+				crt.Lines[0].Tax = testRetainedTotal("IRNR")
+			},
+		},
+		{
+			name: "resident and non-resident retentions",
+			edit: func(crt *bill.Certificate) {
+				crt.Lines = append(crt.Lines, &bill.CertificateLine{
+					Document: &org.DocumentRef{
+						Code:      "002",
+						IssueDate: cal.NewDate(2024, 3, 1),
+					},
+					Tax: testRetainedTotal("IRNR"),
+				})
+			},
+			err: "[GOBL-ES-BILL-CERTIFICATE-03] ($.tax) certificate cannot combine IRPF and IRNR retentions",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crt := testCertificate(t)
+			if tt.edit != nil {
+				tt.edit(crt)
+			}
+			require.NoError(t, crt.Calculate())
+			err := rules.Validate(crt)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("other regimes", func(t *testing.T) {
+		crt := testCertificate(t)
+		crt.Regime = tax.WithRegime("PT")
+		crt.Period.Start = cal.MakeDate(2023, 7, 1)
+		require.NoError(t, crt.Calculate())
+		err := rules.Validate(crt)
+		require.Error(t, err, "IRPF is not retained in Portugal")
+		assert.NotContains(t, err.Error(), "GOBL-ES-BILL-CERTIFICATE")
+	})
+}
@@ -20,8 +20,10 @@ func init() {
 		"es",
 		rules.GOBL.Add(CountryCode),
 		billInvoiceRules(),
+		billCertificateRules(),
 		taxIdentityRules(),
 	)
+	rules.RegisterTranslations(faultTranslations)
 	// Tax identities are normalized by their own country's regime, so the
 	// guard matches the identity's country rather than the document context.
 	norm.Register(
@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
 	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/regimes/es"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/tax"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNormalizeTaxIdentity(t *testing.T) {
@@ -222,3 +224,13 @@ func TestValidateTaxIdentity(t *testing.T) {
 		})
 	}
 }
+
+func TestTaxIdentityFaultTranslation(t *testing.T) {
+	tID := &tax.Identity{Country: "ES", Code: "B98602641"}
+	faults := rules.Validate(tID)
+	require.Error(t, faults)
+	f := faults.First()
+	assert.Equal(t, rules.Code("GOBL-ES-TAX-IDENTITY-01"), f.Code())
+	assert.Equal(t, "invalid Spanish VAT identity code format or checksum", f.MessageIn(i18n.EN))
+	assert.Equal(t, "formato o dígito de control del NIF español no válido", f.MessageIn(i18n.ES))
+}
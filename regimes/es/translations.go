@@ -0,0 +1,32 @@
+package es
+
+import (
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+)
+
+// faultTranslations provides Spanish versions of the fault messages
+// defined by the regime's rules.
+var faultTranslations = rules.Translations{
+	"GOBL-ES-BILL-INVOICE-01": {
+		i18n.ES: "el proveedor de la factura en España es obligatorio",
+	},
+	"GOBL-ES-BILL-INVOICE-02": {
+		i18n.ES: "el NIF del proveedor de la factura en España es obligatorio",
+	},
+	"GOBL-ES-BILL-INVOICE-03": {
+		i18n.ES: "el código del NIF del proveedor de la factura en España es obligatorio",
+	},
+	"GOBL-ES-BILL-CERTIFICATE-01": {
+		i18n.ES: "el periodo del certificado debe estar dentro de un mismo año natural",
+	},
+	"GOBL-ES-BILL-CERTIFICATE-02": {
+		i18n.ES: "el NIF del cliente del certificado debe ser español",
+	},
+	"GOBL-ES-BILL-CERTIFICATE-03": {
+		i18n.ES: "el certificado no puede combinar retenciones de IRPF e IRNR",
+	},
+	"GOBL-ES-TAX-IDENTITY-01": {
+		i18n.ES: "formato o dígito de control del NIF español no válido",
+	},
+}
@@ -0,0 +1,158 @@
+package hr_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(hr.CountryCode),
+		Code:      "1-1-1",
+		IssueDate: cal.MakeDate(2025, 6, 1),
+		Supplier: &org.Party{
+			Name: "Dobavljač d.o.o.",
+			TaxID: &tax.Identity{
+				Country: "HR",
+				Code:    "69435151530",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Kupac d.d.",
+			TaxID: &tax.Identity{
+				Country: "HR",
+				Code:    "94577403194",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Građevinski radovi",
+					Price: num.NewAmount(1000, 0),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2025, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "2500.00",
+		},
+		{
+			name: "intermediate",
+			date: cal.MakeDate(2025, 6, 1),
+			rate: tax.RateIntermediate,
+			tax:  "1300.00",
+		},
+		{
+			name: "intermediate before 2014",
+			date: cal.MakeDate(2013, 8, 1),
+			rate: tax.RateIntermediate,
+			tax:  "1000.00",
+		},
+		{
+			name: "reduced",
+			date: cal.MakeDate(2025, 6, 1),
+			rate: tax.RateReduced,
+			tax:  "500.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "EUR", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-BILL-INVOICE-01]")
+	})
+	t.Run("reverse charge without customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(tax.TagReverseCharge)
+		inv.Customer.TaxID.Code = ""
+		inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HR-BILL-INVOICE-04]")
+	})
+}
+
+func TestInvoiceReverseChargeScenarios(t *testing.T) {
+	tests := []struct {
+		name    string
+		country l10n.TaxCountryCode
+		code    cbc.Code
+		text    string
+	}{
+		{
+			name:    "domestic",
+			country: "HR",
+			code:    "94577403194",
+			text:    "Prijenos porezne obveze sukladno članku 75. stavku 3. Zakona o PDV-u.",
+		},
+		{
+			name:    "intra-community",
+			country: "DE",
+			code:    "111111125",
+			text:    "Reverse charge / Prijenos porezne obveze.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.SetTags(tax.TagReverseCharge)
+			inv.Customer.TaxID = &tax.Identity{Country: tt.country, Code: tt.code}
+			inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			require.Len(t, inv.Tax.Notes, 1)
+			assert.Equal(t, tax.KeyReverseCharge, inv.Tax.Notes[0].Key)
+			assert.Equal(t, tt.text, inv.Tax.Notes[0].Text)
+		})
+	}
+}
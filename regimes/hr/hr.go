@@ -0,0 +1,91 @@
+// Package hr provides the tax regime definition for Croatia.
+package hr
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Croatia.
+const CountryCode = "HR"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("hr", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgIdentityRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeOrgIdentity),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.EUR,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Croatia",
+			i18n.HR: "Hrvatska",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Croatia's VAT (Porez na dodanu vrijednost, PDV) is administered by the
+				Tax Administration (Porezna uprava) under the VAT Act (Zakon o porezu
+				na dodanu vrijednost). The euro replaced the kuna as the official
+				currency on 1 January 2023.
+
+				Every person and company in Croatia is identified by their OIB
+				(osobni identifikacijski broj), an 11 digit number whose last digit
+				is an ISO 7064 MOD 11,10 check digit. The OIB doubles as the VAT
+				number when prefixed with HR.
+
+				Domestic reverse charge (prijenos porezne obveze) under Article 75
+				of the VAT Act applies to specific supplies such as construction
+				services and scrap materials, in which case the customer accounts
+				for the tax and the invoice must say so.
+
+				From 2026 domestic B2B invoices must be exchanged electronically
+				and reported under the Fiscalization 2.0 (Fiskalizacija 2.0)
+				rules, see the ~hr-fiskalizacija-v2~ addon.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Zakon o porezu na dodanu vrijednost"),
+				URL:   "https://www.zakon.hr/z/186/Zakon-o-porezu-na-dodanu-vrijednost",
+			},
+			{
+				Title: i18n.NewString("Porezna uprava - OIB"),
+				URL:   "https://www.porezna-uprava.hr/HR_OIB/Stranice/default.aspx",
+			},
+		},
+		TimeZone:   "Europe/Zagreb",
+		Identities: identityTypeDefinitions,
+		Scenarios: []*tax.ScenarioSet{
+			invoiceScenarios,
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
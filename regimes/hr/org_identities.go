@@ -0,0 +1,56 @@
+package hr
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// IdentityTypeOIB represents the Croatian personal identification
+	// number (osobni identifikacijski broj) assigned to every person and
+	// company, used to identify individuals such as invoice operators who
+	// do not have a tax ID of their own.
+	IdentityTypeOIB cbc.Code = "OIB"
+)
+
+var identityTypeDefinitions = []*cbc.Definition{
+	{
+		Code: IdentityTypeOIB,
+		Name: i18n.String{
+			i18n.EN: "Personal Identification Number",
+			i18n.HR: "Osobni identifikacijski broj",
+		},
+		Desc: i18n.String{
+			i18n.EN: "Croatian 11 digit identification number assigned by the Tax Administration.",
+			i18n.HR: "Jedanaesteroznamenkasti identifikacijski broj koji dodjeljuje Porezna uprava.",
+		},
+	},
+}
+
+func orgIdentityRules() *rules.Set {
+	return rules.For(new(org.Identity),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				org.IdentityTypeIn(IdentityTypeOIB),
+				rules.Field("code",
+					rules.Assert("01", "invalid OIB",
+						is.Func("valid OIB", isValidOIB),
+					),
+				),
+			),
+		),
+	)
+}
+
+// normalizeOrgIdentity strips non-numeric characters from the OIB.
+func normalizeOrgIdentity(id *org.Identity) {
+	if id == nil || id.Type != IdentityTypeOIB {
+		return
+	}
+	id.Code = cbc.NormalizeNumericalCode(id.Code)
+}
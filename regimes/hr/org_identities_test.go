@@ -0,0 +1,50 @@
+package hr_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOrgIdentity(t *testing.T) {
+	id := &org.Identity{Type: hr.IdentityTypeOIB, Code: "6943 5151 530"}
+	norm.Normalize(id, tax.RegimeContext(hr.CountryCode))
+	assert.Equal(t, cbc.Code("69435151530"), id.Code)
+
+	id = &org.Identity{Type: "OTHER", Code: "123 456"}
+	norm.Normalize(id, tax.RegimeContext(hr.CountryCode))
+	assert.Equal(t, cbc.Code("123 456"), id.Code)
+}
+
+func TestValidateOrgIdentity(t *testing.T) {
+	opts := []rules.WithContext{
+		tax.RegimeContext(hr.CountryCode),
+	}
+	tests := []struct {
+		name  string
+		code  cbc.Code
+		valid bool
+	}{
+		{name: "valid code", code: "33813276504", valid: true},
+		{name: "empty code", code: ""},
+		{name: "bad check digit", code: "33813276505"},
+		{name: "too short", code: "3381327650"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := &org.Identity{Type: hr.IdentityTypeOIB, Code: tt.code}
+			err := rules.Validate(id, opts...)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), "[GOBL-HR-ORG-IDENTITY-01]")
+			}
+		})
+	}
+}
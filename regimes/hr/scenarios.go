@@ -0,0 +1,15 @@
+package hr
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: bill.ReverseChargeScenarios(
+		CountryCode,
+		"Reverse charge / Prijenos porezne obveze.",
+		"Prijenos porezne obveze sukladno članku 75. stavku 3. Zakona o PDV-u.",
+	),
+}
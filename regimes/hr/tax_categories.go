@@ -0,0 +1,89 @@
+package hr
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.HR: "PDV",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.HR: "Porez na dodanu vrijednost",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "Tax Administration - VAT",
+					i18n.HR: "Porezna uprava - PDV",
+				},
+				URL: "https://www.porezna-uprava.hr/HR_porezni_sustav/Stranice/porez_na_dodanu_vrijednost.aspx",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.HR: "Opća stopa",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2012, 3, 1),
+						Percent: num.MakePercentage(250, 3),
+					},
+					{
+						Since:   cal.NewDate(2009, 8, 1),
+						Percent: num.MakePercentage(230, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateIntermediate,
+				Name: i18n.String{
+					i18n.EN: "Intermediate Rate",
+					i18n.HR: "Snižena stopa",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2014, 1, 1),
+						Percent: num.MakePercentage(130, 3),
+					},
+					{
+						Since:   cal.NewDate(2013, 1, 1),
+						Percent: num.MakePercentage(100, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.HR: "Najniža snižena stopa",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2013, 1, 1),
+						Percent: num.MakePercentage(50, 3),
+					},
+				},
+			},
+		},
+	},
+}
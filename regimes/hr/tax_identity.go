@@ -0,0 +1,56 @@
+package hr
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Tax identity codes are the 11 digit OIB used for every person and
+// company in Croatia.
+var oibRegexp = regexp.MustCompile(`^\d{11}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Croatian tax identity code",
+					is.Func("valid OIB", isValidOIB),
+				),
+			),
+		),
+	)
+}
+
+func isValidOIB(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !oibRegexp.MatchString(val) {
+		return false
+	}
+	return oibCheckDigit(val[:10]) == int(val[10]-'0')
+}
+
+// oibCheckDigit calculates the ISO 7064 MOD 11,10 check digit of the
+// first ten digits of an OIB.
+func oibCheckDigit(val string) int {
+	a := 10
+	for i := 0; i < len(val); i++ {
+		a = (a + int(val[i]-'0')) % 10
+		if a == 0 {
+			a = 10
+		}
+		a = (a * 2) % 11
+	}
+	check := 11 - a
+	if check == 10 {
+		check = 0
+	}
+	return check
+}
@@ -0,0 +1,103 @@
+package hr_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/hr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips HR prefix and hyphens",
+			inputCode:    "HR 6943-5151-530",
+			expectedCode: "69435151530",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "69435151530",
+			expectedCode: "69435151530",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "HR", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "valid",
+			inputCode: "69435151530",
+		},
+		{
+			name:      "valid 2",
+			inputCode: "94577403194",
+		},
+		{
+			name:      "valid 3",
+			inputCode: "12345678903",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "69435151531",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "6943515153",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too long",
+			inputCode:   "694351515300",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "letters",
+			inputCode:   "6943515153A",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "HR", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package hu
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Invoice rules cover the requirements of § 169 of the VAT act, where
+// Hungarian suppliers must be identified by their complete domestic tax
+// number, and customers by their tax number when accounting for the tax.
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+					rules.When(tax.IdentityIn(CountryCode),
+						rules.Field("code",
+							rules.AssertIfPresent("02", "invoice supplier tax ID code must use the domestic tax number format (xxxxxxxx-y-zz)",
+								is.Func("domestic format", isDomesticTaxCode),
+							),
+							rules.AssertIfPresent("03", "invoice supplier tax ID code cannot be a group identification number",
+								is.Func("not group", isNotGroupTaxCode),
+							),
+						),
+					),
+				),
+			),
+			rules.When(is.Func("reverse charge", isReverseChargeInvoice),
+				rules.Field("customer",
+					rules.Assert("04", "invoice customer is required for reverse charge", is.Present),
+					rules.Field("tax_id",
+						rules.Assert("05", "invoice customer tax ID is required for reverse charge", is.Present),
+						rules.Field("code",
+							rules.Assert("06", "invoice customer tax ID code is required for reverse charge", is.Present),
+						),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isReverseChargeInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && inv.HasTags(tax.TagReverseCharge)
+}
+
+// isNotGroupTaxCode ensures members of a VAT group issue invoices with
+// their own group member tax number.
+func isNotGroupTaxCode(val any) bool {
+	code, ok := val.(cbc.Code)
+	return ok && taxCodeVATCode(code) != vatCodeGroup
+}
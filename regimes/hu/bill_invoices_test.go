@@ -0,0 +1,169 @@
+package hu_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/hu"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(hu.CountryCode),
+		Code:      "HU-2025-001",
+		IssueDate: cal.MakeDate(2025, 3, 1),
+		Supplier: &org.Party{
+			Name: "Szállító Kft.",
+			TaxID: &tax.Identity{
+				Country: "HU",
+				Code:    "12892312241",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Vevő Zrt.",
+			TaxID: &tax.Identity{
+				Country: "HU",
+				Code:    "13895459213",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Építési munka",
+					Price: num.NewAmount(10000, 0),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2025, 3, 1),
+			rate: tax.RateGeneral,
+			tax:  "27000",
+		},
+		{
+			name: "general before 2012",
+			date: cal.MakeDate(2011, 12, 31),
+			rate: tax.RateGeneral,
+			tax:  "25000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "HUF", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HU-BILL-INVOICE-01]")
+	})
+	t.Run("supplier EU VAT number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID.Code = "12892312"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HU-BILL-INVOICE-02]")
+	})
+	t.Run("supplier group identification number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID.Code = "17781774-5-44"
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HU-BILL-INVOICE-03]")
+	})
+	t.Run("supplier group member", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID.Code = "10597190-4-44"
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("foreign supplier", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = &tax.Identity{Country: "DE", Code: "111111125"}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("reverse charge without customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(tax.TagReverseCharge)
+		inv.Customer.TaxID = nil
+		inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-HU-BILL-INVOICE-05]")
+	})
+}
+
+func TestInvoiceReverseChargeScenarios(t *testing.T) {
+	tests := []struct {
+		name    string
+		country l10n.TaxCountryCode
+		code    cbc.Code
+		text    string
+	}{
+		{
+			name:    "domestic",
+			country: "HU",
+			code:    "13895459213",
+			text:    "Fordított adózás az Áfa tv. 142. § alapján.",
+		},
+		{
+			name:    "intra-community",
+			country: "DE",
+			code:    "111111125",
+			text:    "Reverse charge / Fordított adózás.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.SetTags(tax.TagReverseCharge)
+			inv.Customer.TaxID = &tax.Identity{Country: tt.country, Code: tt.code}
+			inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			require.Len(t, inv.Tax.Notes, 1)
+			assert.Equal(t, tt.text, inv.Tax.Notes[0].Text)
+		})
+	}
+}
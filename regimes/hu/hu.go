@@ -0,0 +1,80 @@
+// Package hu provides the tax regime definition for Hungary.
+package hu
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Hungary.
+const CountryCode = "HU"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("hu", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.HUF,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Hungary",
+			i18n.HU: "Magyarország",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Hungary's VAT (Általános forgalmi adó, ÁFA) is administered by the
+				National Tax and Customs Administration (NAV) under Act CXXVII of
+				2007. The general rate of 27% is the highest in the EU, with reduced
+				rates of 18% and 5%.
+
+				Businesses are identified by their 11 digit domestic tax number
+				(adószám) in the format xxxxxxxx-y-zz, made up of the 8 digit base
+				number (törzsszám) with a check digit, the VAT code, and the county
+				code. The EU VAT number consists of the HU prefix and the base
+				number only. Members of a VAT group use the VAT code 4, while the
+				group itself has a group identification number (csoportazonosító
+				szám) starting with 17 and using the VAT code 5.
+
+				Domestic invoices must include the supplier's domestic tax number.
+				Domestic reverse charge (fordított adózás) under § 142 applies to
+				supplies such as construction work, waste, and agricultural
+				products, and the invoice must include the words "fordított adózás".
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("2007. évi CXXVII. törvény az általános forgalmi adóról"),
+				URL:   "https://net.jogtar.hu/jogszabaly?docid=a0700127.tv",
+			},
+		},
+		TimeZone: "Europe/Budapest",
+		Scenarios: []*tax.ScenarioSet{
+			invoiceScenarios,
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package hu
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: bill.ReverseChargeScenarios(
+		CountryCode,
+		"Reverse charge / Fordított adózás.",
+		"Fordított adózás az Áfa tv. 142. § alapján.",
+	),
+}
@@ -0,0 +1,93 @@
+package hu
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.HU: "ÁFA",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.HU: "Általános forgalmi adó",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "NAV - VAT rates",
+					i18n.HU: "NAV - Áfakulcsok",
+				},
+				URL: "https://nav.gov.hu/ado/afa",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.HU: "Általános adókulcs",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2012, 1, 1),
+						Percent: num.MakePercentage(270, 3),
+					},
+					{
+						Since:   cal.NewDate(2009, 7, 1),
+						Percent: num.MakePercentage(250, 3),
+					},
+					{
+						Since:   cal.NewDate(2006, 1, 1),
+						Percent: num.MakePercentage(200, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 1, 1),
+						Percent: num.MakePercentage(250, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateIntermediate,
+				Name: i18n.String{
+					i18n.EN: "Intermediate Rate",
+					i18n.HU: "Kedvezményes adókulcs (18%)",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2009, 7, 1),
+						Percent: num.MakePercentage(180, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.HU: "Kedvezményes adókulcs (5%)",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2004, 1, 1),
+						Percent: num.MakePercentage(50, 3),
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,86 @@
+package hu
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// VAT code used in the ninth digit of a VAT group's domestic tax number,
+// whose base number must start with groupBasePrefix. Group members use
+// the VAT code 4 with their own base number.
+const (
+	vatCodeGroup    cbc.Code = "5"
+	groupBasePrefix          = "17"
+)
+
+// Tax codes are either the 8 digit base number used in EU VAT numbers,
+// or the complete 11 digit domestic tax number including the VAT code
+// (1 to 5) and county code.
+var (
+	taxCodeRegexp         = regexp.MustCompile(`^\d{8}$`)
+	domesticTaxCodeRegexp = regexp.MustCompile(`^\d{8}[1-5](0[2-9]|1\d|20|22|4[1-4]|51)$`)
+	taxCodeWeights        = []int{9, 7, 3, 1, 9, 7, 3}
+)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Hungarian tax identity code",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+				rules.AssertIfPresent("02", "group identification numbers must have a base number starting with 17",
+					is.Func("valid group", isValidGroupTaxCode),
+				),
+			),
+		),
+	)
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !taxCodeRegexp.MatchString(val) && !domesticTaxCodeRegexp.MatchString(val) {
+		return false
+	}
+	sum := 0
+	for i, w := range taxCodeWeights {
+		sum += int(val[i]-'0') * w
+	}
+	check := (10 - sum%10) % 10
+	return check == int(val[7]-'0')
+}
+
+func isValidGroupTaxCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok {
+		return false
+	}
+	if taxCodeVATCode(code) != vatCodeGroup {
+		return true
+	}
+	return strings.HasPrefix(code.String(), groupBasePrefix)
+}
+
+// isDomesticTaxCode checks the code is in the complete 11 digit format.
+func isDomesticTaxCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	return ok && domesticTaxCodeRegexp.MatchString(code.String())
+}
+
+// taxCodeVATCode returns the VAT code digit from a domestic tax number, or
+// an empty code if not available.
+func taxCodeVATCode(code cbc.Code) cbc.Code {
+	if !domesticTaxCodeRegexp.MatchString(code.String()) {
+		return cbc.CodeEmpty
+	}
+	return code[8:9]
+}
@@ -0,0 +1,131 @@
+package hu_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/hu"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips hyphens",
+			inputCode:    "12892312-2-41",
+			expectedCode: "12892312241",
+		},
+		{
+			name:         "strips HU prefix",
+			inputCode:    "HU12892312",
+			expectedCode: "12892312",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "12892312241",
+			expectedCode: "12892312241",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "HU", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "eu base number",
+			inputCode: "12892312",
+		},
+		{
+			name:      "domestic",
+			inputCode: "12892312241",
+		},
+		{
+			name:      "domestic budapest",
+			inputCode: "13895459213",
+		},
+		{
+			name:      "group",
+			inputCode: "17781774544",
+		},
+		{
+			name:      "group member",
+			inputCode: "10597190444",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "12892313",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "domestic bad checksum",
+			inputCode:   "12892313241",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad vat code",
+			inputCode:   "12892312641",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad county code",
+			inputCode:   "12892312299",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "group without prefix",
+			inputCode:   "12892312541",
+			expectedErr: "IDENTITY-02",
+		},
+		{
+			name:        "too short",
+			inputCode:   "1289231",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "partial domestic",
+			inputCode:   "128923122",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "HU", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
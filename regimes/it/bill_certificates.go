@@ -0,0 +1,35 @@
+package it
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Withholding certificates in Italy (Certificazione Unica) are issued by the
+// withholding agent (sostituto d'imposta) for the ritenute applied during a
+// calendar year.
+func billCertificateRules() *rules.Set {
+	return rules.For(new(bill.Certificate),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("period",
+				rules.Assert("01", "certificate period must be within a single calendar year",
+					cal.PeriodInYear(),
+				),
+			),
+			rules.Field("customer",
+				rules.Field("tax_id",
+					rules.Field("country",
+						rules.Assert("02", "certificate customer tax ID must be Italian",
+							is.In(l10n.TaxCountryCode(CountryCode)),
+						),
+					),
+				),
+			),
+		),
+	)
+}
@@ -18,7 +18,8 @@ const CountryCode = "IT"
 
 func init() {
 	tax.RegisterRegimeDef(New())
-	rules.Register("it", rules.GOBL.Add(CountryCode), taxIdentityRules(), orgIdentityRules())
+	rules.Register("it", rules.GOBL.Add(CountryCode), billCertificateRules(), taxIdentityRules(), orgIdentityRules())
+	rules.RegisterTranslations(faultTranslations)
 	norm.Register(
 		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
 	)
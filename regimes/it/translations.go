@@ -0,0 +1,29 @@
+package it
+
+import (
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+)
+
+// faultTranslations provides Italian versions of the fault messages
+// defined by the regime's rules.
+var faultTranslations = rules.Translations{
+	"GOBL-IT-BILL-CERTIFICATE-01": {
+		i18n.IT: "il periodo del certificato deve rientrare in un unico anno solare",
+	},
+	"GOBL-IT-BILL-CERTIFICATE-02": {
+		i18n.IT: "la partita IVA del cliente del certificato deve essere italiana",
+	},
+	"GOBL-IT-TAX-IDENTITY-01": {
+		i18n.IT: "partita IVA italiana non valida",
+	},
+	"GOBL-IT-ORG-IDENTITY-01": {
+		i18n.IT: "il codice fiscale è obbligatorio",
+	},
+	"GOBL-IT-ORG-IDENTITY-02": {
+		i18n.IT: "formato del codice fiscale non valido",
+	},
+	"GOBL-IT-ORG-IDENTITY-03": {
+		i18n.IT: "carattere di controllo del codice fiscale non valido",
+	},
+}
@@ -0,0 +1,64 @@
+package jp
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Invoice rules enforce the requirements of qualified invoices (適格請求書)
+// defined by the Qualified Invoice System.
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required for qualified invoices", is.Present),
+					rules.Field("code",
+						rules.Assert("02", "invoice supplier registration number is required for qualified invoices", is.Present),
+					),
+				),
+			),
+			// Simplified qualified invoices may omit the customer.
+			rules.When(is.Func("not simplified", isNotSimplifiedInvoice),
+				rules.Field("customer",
+					rules.Assert("03", "invoice customer is required for qualified invoices", is.Present),
+					rules.Field("name",
+						rules.Assert("04", "invoice customer name is required for qualified invoices", is.Present),
+					),
+				),
+			),
+			// Each line must indicate its rate so that totals can be
+			// provided per rate.
+			rules.Field("lines",
+				rules.Each(
+					rules.Field("taxes",
+						rules.Assert("05", "invoice line consumption tax rate is required",
+							is.Func("has consumption tax", hasConsumptionTax),
+						),
+					),
+				),
+			),
+			// Tax amounts may only be rounded once per rate.
+			rules.Field("tax",
+				rules.Field("rounding",
+					rules.AssertIfPresent("06", "invoice tax rounding must be precise to round once per rate",
+						is.In(tax.RoundingRulePrecise),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isNotSimplifiedInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && !inv.HasTags(tax.TagSimplified)
+}
+
+func hasConsumptionTax(val any) bool {
+	ts, ok := val.(tax.Set)
+	return ok && ts.Get(tax.CategoryVAT) != nil
+}
@@ -0,0 +1,114 @@
+package jp_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/jp"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(jp.CountryCode),
+		Code:      "0001",
+		IssueDate: cal.MakeDate(2024, 4, 1),
+		Supplier: &org.Party{
+			Name: "Test Supplier",
+			TaxID: &tax.Identity{
+				Country: "JP",
+				Code:    "T7000012050002",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Test Customer",
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(3, 0),
+				Item: &org.Item{
+					Name:  "Consulting",
+					Price: num.NewAmount(333, 0),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+			{
+				Quantity: num.MakeAmount(1, 0),
+				Item: &org.Item{
+					Name:  "Coffee beans",
+					Price: num.NewAmount(1299, 0),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateReduced},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRounding(t *testing.T) {
+	inv := validInvoice()
+	require.NoError(t, inv.Calculate())
+	assert.Equal(t, "JPY", inv.Currency.String())
+	ct := inv.Totals.Taxes.Category(tax.CategoryVAT)
+	require.NotNil(t, ct)
+	require.Len(t, ct.Rates, 2)
+	assert.Equal(t, "999", ct.Rates[0].Base.String())
+	assert.Equal(t, "100", ct.Rates[0].Amount.String(), "rounded once per rate")
+	assert.Equal(t, "1299", ct.Rates[1].Base.String())
+	assert.Equal(t, "104", ct.Rates[1].Amount.String())
+	assert.Equal(t, "2502", inv.Totals.Payable.String())
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier registration number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = &tax.Identity{Country: "JP"}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-JP-BILL-INVOICE-02]")
+	})
+	t.Run("missing customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-JP-BILL-INVOICE-03]")
+	})
+	t.Run("missing customer name", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.Name = ""
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-JP-BILL-INVOICE-04]")
+	})
+	t.Run("simplified without customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer = nil
+		inv.SetTags(tax.TagSimplified)
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("line without rate", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[1].Taxes = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-JP-BILL-INVOICE-05] ($.lines[1].taxes)")
+	})
+	t.Run("rounding per line", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Tax = &bill.Tax{Rounding: tax.RoundingRuleCurrency}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-JP-BILL-INVOICE-06]")
+	})
+}
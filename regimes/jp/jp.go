@@ -0,0 +1,83 @@
+// Package jp provides the tax region definition for Japan.
+package jp
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Japan
+const CountryCode = "JP"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("jp", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(normalizeTaxIdentity)),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.JPY,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Japan",
+			i18n.JA: "日本",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "National Tax Agency - Qualified Invoice System",
+					i18n.JA: "国税庁 - インボイス制度",
+				},
+				URL: "https://www.nta.go.jp/taxes/shiraberu/zeimokubetsu/shohi/keigenzeiritsu/invoice.htm",
+			},
+		},
+		TimeZone: "Asia/Tokyo",
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Japan applies a Consumption Tax (JCT) administered by the National Tax Agency
+				(NTA), composed of a national and a local portion, with a standard rate of 10%
+				and a reduced rate of 8% for food and beverages, excluding alcohol and dining
+				out, and for newspaper subscriptions.
+
+				Since October 2023 the Qualified Invoice System requires suppliers registered
+				with the NTA to issue qualified invoices in order for their customers to deduct
+				input tax. Registered suppliers are identified by their registration number,
+				the letter "T" followed by 13 digits, which for corporations matches their
+				corporate number. GOBL stores only the 13 digits in the tax ID code.
+
+				Qualified invoices must include the supplier's name and registration number,
+				the transaction date, a description of the items, indicating those subject to
+				the reduced rate, the totals and tax amounts for each rate, and the customer's
+				name. The tax amount must be rounded only once per rate for the whole invoice,
+				which corresponds to GOBL's precise rounding model. Simplified qualified invoices
+				may be issued by retailers, restaurants and taxis, omitting the customer.
+			`),
+		},
+		CalculatorRoundingRule: tax.RoundingRulePrecise,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				// Qualified return invoices (適格返還請求書) are used to
+				// reduce the amounts of a previous invoice.
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+		Categories: taxCategories(),
+	}
+}
@@ -0,0 +1,87 @@
+package jp
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxCategories() []*tax.CategoryDef {
+	return []*tax.CategoryDef{
+		// Consumption Tax
+		{
+			Code: tax.CategoryVAT,
+			Name: i18n.String{
+				i18n.EN: "JCT",
+				i18n.JA: "消費税",
+			},
+			Title: i18n.String{
+				i18n.EN: "Japanese Consumption Tax",
+				i18n.JA: "消費税及び地方消費税",
+			},
+			Sources: []*cbc.Source{
+				{
+					Title: i18n.String{
+						i18n.EN: "National Tax Agency - Consumption Tax Rates",
+						i18n.JA: "国税庁 - 消費税及び地方消費税の税率",
+					},
+					URL: "https://www.nta.go.jp/taxes/shiraberu/taxanswer/shohi/6303.htm",
+				},
+			},
+			Retained: false,
+			Keys:     tax.GlobalVATKeys(),
+			Rates: []*tax.RateDef{
+				{
+					Keys: []cbc.Key{tax.KeyStandard},
+					Rate: tax.RateGeneral,
+					Name: i18n.String{
+						i18n.EN: "Standard rate",
+						i18n.JA: "標準税率",
+					},
+					Description: i18n.String{
+						i18n.EN: "Applies to all goods and services not subject to the reduced rate, including 2.2% of local consumption tax.",
+						i18n.JA: "軽減税率の対象以外の全ての取引に適用され、地方消費税2.2%を含みます。",
+					},
+					Values: []*tax.RateValueDef{
+						{
+							Since:   cal.NewDate(2019, 10, 1),
+							Percent: num.MakePercentage(10, 2),
+						},
+						{
+							Since:   cal.NewDate(2014, 4, 1),
+							Percent: num.MakePercentage(8, 2),
+						},
+						{
+							Since:   cal.NewDate(1997, 4, 1),
+							Percent: num.MakePercentage(5, 2),
+						},
+						{
+							Since:   cal.NewDate(1989, 4, 1),
+							Percent: num.MakePercentage(3, 2),
+						},
+					},
+				},
+				{
+					Keys: []cbc.Key{tax.KeyStandard},
+					Rate: tax.RateReduced,
+					Name: i18n.String{
+						i18n.EN: "Reduced rate",
+						i18n.JA: "軽減税率",
+					},
+					Description: i18n.String{
+						i18n.EN: "Applies to food and beverages, excluding alcohol and dining out, and to newspapers published at least twice a week under subscription, including 1.76% of local consumption tax.",
+						i18n.JA: "酒類・外食を除く飲食料品及び週2回以上発行される定期購読契約の新聞に適用され、地方消費税1.76%を含みます。",
+					},
+					Values: []*tax.RateValueDef{
+						{
+							Since:   cal.NewDate(2019, 10, 1),
+							Percent: num.MakePercentage(8, 2),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package jp
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Qualified invoice issuer registration numbers are composed of the letter "T"
+// followed by 13 digits, where the first digit is a check digit calculated
+// over the remaining 12 using the same method as corporate numbers.
+//
+// Reference: https://www.houjin-bangou.nta.go.jp/documents/checkdigit.pdf
+
+var taxCodeRegexp = regexp.MustCompile(`^\d{13}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Japanese registration number",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+			),
+		),
+	)
+}
+
+// normalizeTaxIdentity removes the "T" prefix from registration numbers
+// so that only the 13 digits are stored.
+func normalizeTaxIdentity(tID *tax.Identity) {
+	if tID == nil {
+		return
+	}
+	tax.NormalizeIdentity(tID)
+	tID.Code = cbc.Code(strings.TrimPrefix(tID.Code.String(), "T"))
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	return validateTaxCode(code) == nil
+}
+
+func validateTaxCode(code cbc.Code) error {
+	val := code.String()
+	if !taxCodeRegexp.MatchString(val) {
+		return errors.New("invalid format")
+	}
+	if int(val[0]-'0') != checkDigit(val[1:]) {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
+// checkDigit calculates the check digit for the 12 base digits, where
+// digits in even positions counting from the right are doubled.
+func checkDigit(base string) int {
+	sum := 0
+	for i := range len(base) {
+		d := int(base[len(base)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+		}
+		sum += d
+	}
+	return 9 - sum%9
+}
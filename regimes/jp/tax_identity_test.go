@@ -0,0 +1,118 @@
+package jp_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/jp"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips T prefix",
+			inputCode:    "T7000012050002",
+			expectedCode: "7000012050002",
+		},
+		{
+			name:         "strips lower case prefix",
+			inputCode:    "t7000012050002",
+			expectedCode: "7000012050002",
+		},
+		{
+			name:         "strips hyphens",
+			inputCode:    "T-7000-0120-50002",
+			expectedCode: "7000012050002",
+		},
+		{
+			name:         "strips JP prefix",
+			inputCode:    "JP T7000012050002",
+			expectedCode: "7000012050002",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "1180301018771",
+			expectedCode: "1180301018771",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "JP", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "national tax agency",
+			inputCode: "7000012050002",
+		},
+		{
+			name:      "corporation",
+			inputCode: "1180301018771",
+		},
+		{
+			name:      "empty code",
+			inputCode: "",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "8000012050002",
+			expectedErr: "[GOBL-JP-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "too short",
+			inputCode:   "700001205000",
+			expectedErr: "[GOBL-JP-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "too long",
+			inputCode:   "70000120500021",
+			expectedErr: "[GOBL-JP-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "with prefix",
+			inputCode:   "T7000012050002",
+			expectedErr: "[GOBL-JP-TAX-IDENTITY-01]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "JP", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package ke
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+					rules.Field("code",
+						rules.Assert("02", "invoice supplier KRA PIN is required", is.Present),
+					),
+				),
+			),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote, bill.InvoiceTypeDebitNote),
+				rules.Field("preceding",
+					rules.Assert("03", "preceding documents are required for credit and debit notes", is.Present),
+				),
+			),
+		),
+	)
+}
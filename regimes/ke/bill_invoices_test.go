@@ -0,0 +1,181 @@
+package ke_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/ke"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(ke.CountryCode),
+		Code:      "INV-001",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Supplier Ltd",
+			TaxID: &tax.Identity{
+				Country: "KE",
+				Code:    "P051234567X",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Customer Ltd",
+			TaxID: &tax.Identity{
+				Country: "KE",
+				Code:    "P052345678Y",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Consulting services",
+					Price: num.NewAmount(100000, 2),
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						ke.ExtKeyETIMSItemClass: "8111150000",
+						ke.ExtKeyETIMSItemType:  "3",
+					}),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2024, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "1600.00",
+		},
+		{
+			name: "general during the pandemic",
+			date: cal.MakeDate(2020, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "1400.00",
+		},
+		{
+			name: "reduced no longer available",
+			date: cal.MakeDate(2024, 6, 1),
+			rate: tax.RateReduced,
+			err:  "rate value unavailable",
+		},
+		{
+			name: "reduced before 2023",
+			date: cal.MakeDate(2022, 6, 1),
+			rate: tax.RateReduced,
+			tax:  "800.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestTaxComboTaxType(t *testing.T) {
+	tests := []struct {
+		name  string
+		combo *tax.Combo
+		code  cbc.Code
+	}{
+		{
+			name:  "general",
+			combo: &tax.Combo{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+			code:  ke.ETIMSTaxTypeGeneral,
+		},
+		{
+			name:  "exempt",
+			combo: &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyExempt},
+			code:  ke.ETIMSTaxTypeExempt,
+		},
+		{
+			name:  "zero-rated",
+			combo: &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyZero},
+			code:  ke.ETIMSTaxTypeZero,
+		},
+		{
+			name:  "outside scope",
+			combo: &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyOutsideScope},
+			code:  ke.ETIMSTaxTypeNonVAT,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.Lines[0].Taxes[0] = tt.combo
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			assert.Equal(t, tt.code, inv.Lines[0].Taxes[0].Ext.Get(ke.ExtKeyETIMSTaxType))
+		})
+	}
+	t.Run("reduced before 2023", func(t *testing.T) {
+		inv := validInvoice()
+		inv.IssueDate = cal.MakeDate(2022, 6, 1)
+		inv.Lines[0].Taxes[0].Rate = tax.RateReduced
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, ke.ETIMSTaxTypeReduced, inv.Lines[0].Taxes[0].Ext.Get(ke.ExtKeyETIMSTaxType))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes[0].Ext = tax.ExtensionsOf(cbc.CodeMap{ke.ExtKeyETIMSTaxType: "F"})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-KE-TAX-COMBO-01]")
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "KES", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-KE-BILL-INVOICE-01]")
+	})
+	t.Run("invalid item classification", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Ext = inv.Lines[0].Item.Ext.Set(ke.ExtKeyETIMSItemClass, "1234")
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-KE-ORG-ITEM-01]")
+	})
+	t.Run("invalid item type", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Ext = inv.Lines[0].Item.Ext.Set(ke.ExtKeyETIMSItemType, "4")
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-KE-ORG-ITEM-02]")
+	})
+}
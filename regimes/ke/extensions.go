@@ -0,0 +1,109 @@
+package ke
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyETIMSItemClass cbc.Key = "ke-etims-item-class"
+	ExtKeyETIMSItemType  cbc.Key = "ke-etims-item-type"
+	ExtKeyETIMSTaxType   cbc.Key = "ke-etims-tax-type"
+)
+
+// eTIMS tax type codes
+const (
+	ETIMSTaxTypeExempt  cbc.Code = "A"
+	ETIMSTaxTypeGeneral cbc.Code = "B"
+	ETIMSTaxTypeZero    cbc.Code = "C"
+	ETIMSTaxTypeNonVAT  cbc.Code = "D"
+	ETIMSTaxTypeReduced cbc.Code = "E"
+)
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyETIMSItemClass,
+		Name: i18n.String{
+			i18n.EN: "eTIMS Item Classification",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Item classification code from the KRA eTIMS code list, based on
+				the UNSPSC segments with an optional two digit extension.
+			`),
+		},
+		Pattern: `^\d{8}(\d{2})?$`,
+	},
+	{
+		Key: ExtKeyETIMSItemType,
+		Name: i18n.String{
+			i18n.EN: "eTIMS Item Type",
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "1",
+				Name: i18n.String{
+					i18n.EN: "Raw material",
+				},
+			},
+			{
+				Code: "2",
+				Name: i18n.String{
+					i18n.EN: "Finished product",
+				},
+			},
+			{
+				Code: "3",
+				Name: i18n.String{
+					i18n.EN: "Service",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyETIMSTaxType,
+		Name: i18n.String{
+			i18n.EN: "eTIMS Tax Type",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Tax type of a VAT line reported to eTIMS. When not provided it
+				is determined from the tax key and rate.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: ETIMSTaxTypeExempt,
+				Name: i18n.String{
+					i18n.EN: "Exempt",
+				},
+			},
+			{
+				Code: ETIMSTaxTypeGeneral,
+				Name: i18n.String{
+					i18n.EN: "General rate (16%)",
+				},
+			},
+			{
+				Code: ETIMSTaxTypeZero,
+				Name: i18n.String{
+					i18n.EN: "Zero-rated",
+				},
+			},
+			{
+				Code: ETIMSTaxTypeNonVAT,
+				Name: i18n.String{
+					i18n.EN: "Non-VAT",
+				},
+			},
+			{
+				Code: ETIMSTaxTypeReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced rate (8%)",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,87 @@
+// Package ke provides the tax regime definition for Kenya.
+package ke
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Kenya.
+const CountryCode = "KE"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("ke", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgItemRules(),
+		taxComboRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeTaxCombo),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.KES,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Kenya",
+			i18n.SW: "Kenya",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Kenya's tax system is administered by the Kenya Revenue Authority
+				(KRA). VAT is charged at a general rate on the supply of goods and
+				services, with zero-rated and exempt supplies listed in the
+				schedules of the VAT Act 2013.
+
+				Taxpayers are identified by their KRA PIN, made up of a letter
+				indicating individuals (~A~) or other entities (~P~), nine digits,
+				and a final check letter.
+
+				All invoices must be transmitted through the electronic Tax Invoice
+				Management System (eTIMS). Items may declare their eTIMS item
+				classification and type with the ~ke-etims-item-class~ and
+				~ke-etims-item-type~ extensions, while the eTIMS tax type of each
+				VAT line is set in the ~ke-etims-tax-type~ extension, determined
+				automatically from the tax key and rate.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("KRA - Value Added Tax"),
+				URL:   "https://www.kra.go.ke/individual/filing-paying/types-of-taxes/value-added-tax",
+			},
+			{
+				Title: i18n.NewString("KRA - eTIMS"),
+				URL:   "https://www.kra.go.ke/online-services/etims",
+			},
+		},
+		TimeZone:   "Africa/Nairobi",
+		Extensions: extensions,
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+			},
+		},
+	}
+}
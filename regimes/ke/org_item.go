@@ -0,0 +1,28 @@
+package ke
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func orgItemRules() *rules.Set {
+	return rules.For(new(org.Item),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("ext",
+				rules.Assert("01",
+					fmt.Sprintf("item '%s' extension must be a valid classification code", ExtKeyETIMSItemClass),
+					tax.ExtensionHasValidCode(ExtKeyETIMSItemClass),
+				),
+				rules.Assert("02",
+					fmt.Sprintf("item '%s' extension must be a valid item type", ExtKeyETIMSItemType),
+					tax.ExtensionHasValidCode(ExtKeyETIMSItemType),
+				),
+			),
+		),
+	)
+}
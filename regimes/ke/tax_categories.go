@@ -0,0 +1,74 @@
+package ke
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.SW: "Kodi ya Ongezeko la Thamani",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Kenya Law - Value Added Tax Act, 2013"),
+				URL:   "https://new.kenyalaw.org/akn/ke/act/2013/35/eng@2022-12-31",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2021, 1, 1),
+						Percent: num.MakePercentage(160, 3),
+					},
+					{
+						// Temporary reduction during the COVID-19 pandemic.
+						Since:   cal.NewDate(2020, 4, 1),
+						Percent: num.MakePercentage(140, 3),
+					},
+					{
+						Since:   cal.NewDate(2013, 9, 2),
+						Percent: num.MakePercentage(160, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+				},
+				Description: i18n.String{
+					i18n.EN: "Applied to petroleum products until they moved to the general rate in July 2023.",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2018, 9, 21),
+						Until:   cal.NewDate(2023, 6, 30),
+						Percent: num.MakePercentage(80, 3),
+					},
+				},
+			},
+		},
+	},
+}
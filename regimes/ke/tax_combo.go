@@ -0,0 +1,53 @@
+package ke
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxComboRules() *rules.Set {
+	return rules.For(new(tax.Combo),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("ext",
+				rules.Assert("01",
+					fmt.Sprintf("tax combo '%s' extension must be a valid tax type", ExtKeyETIMSTaxType),
+					tax.ExtensionHasValidCode(ExtKeyETIMSTaxType),
+				),
+			),
+		),
+	)
+}
+
+// normalizeTaxCombo sets the eTIMS tax type of VAT combos from the key and
+// rate, unless already provided.
+func normalizeTaxCombo(tc *tax.Combo) {
+	if tc == nil || tc.Category != tax.CategoryVAT || tc.Ext.Has(ExtKeyETIMSTaxType) {
+		return
+	}
+	var code cbc.Code
+	switch tc.Key {
+	case tax.KeyExempt:
+		code = ETIMSTaxTypeExempt
+	case tax.KeyZero, tax.KeyExport:
+		code = ETIMSTaxTypeZero
+	case tax.KeyOutsideScope:
+		code = ETIMSTaxTypeNonVAT
+	case cbc.KeyEmpty, tax.KeyStandard:
+		switch tc.Rate {
+		case tax.RateGeneral:
+			code = ETIMSTaxTypeGeneral
+		case tax.RateReduced:
+			code = ETIMSTaxTypeReduced
+		default:
+			return
+		}
+	default:
+		return
+	}
+	tc.Ext = tc.Ext.Set(ExtKeyETIMSTaxType, code)
+}
@@ -0,0 +1,25 @@
+package ke
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// KRA PINs start with A for individuals or P for non-individuals, followed
+// by nine digits and a check letter.
+var pinRegexp = regexp.MustCompile(`^[AP]\d{9}[A-Z]$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Kenyan KRA PIN",
+					is.MatchesRegexp(pinRegexp),
+				),
+			),
+		),
+	)
+}
@@ -0,0 +1,94 @@
+package ke_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/ke"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "upper case and strips hyphens",
+			inputCode:    "p051-234-567x",
+			expectedCode: "P051234567X",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "P051234567X",
+			expectedCode: "P051234567X",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "KE", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "valid company",
+			inputCode: "P051234567X",
+		},
+		{
+			name:      "valid individual",
+			inputCode: "A001234567B",
+		},
+		{
+			name:        "bad prefix",
+			inputCode:   "B051234567X",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "missing check letter",
+			inputCode:   "P0512345678",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "P05123456X",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "KE", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
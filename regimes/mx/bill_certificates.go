@@ -0,0 +1,35 @@
+package mx
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Withholding certificates in Mexico (constancias de retenciones) are issued
+// by the withholding agent, identified by their RFC, for a range of months
+// within a single fiscal year.
+func billCertificateRules() *rules.Set {
+	return rules.For(new(bill.Certificate),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("period",
+				rules.Assert("01", "certificate period must be within a single fiscal year",
+					cal.PeriodInYear(),
+				),
+			),
+			rules.Field("customer",
+				rules.Field("tax_id",
+					rules.Field("country",
+						rules.Assert("02", "certificate customer tax ID must be Mexican",
+							is.In(l10n.TaxCountryCode(CountryCode)),
+						),
+					),
+				),
+			),
+		),
+	)
+}
@@ -17,7 +17,7 @@ const CountryCode = "MX"
 
 func init() {
 	tax.RegisterRegimeDef(New())
-	rules.Register("mx", rules.GOBL.Add(CountryCode), taxIdentityRules())
+	rules.Register("mx", rules.GOBL.Add(CountryCode), billCertificateRules(), taxIdentityRules())
 	norm.Register(
 		norm.When(tax.IdentityIn(CountryCode),
 			norm.For(normalizeTaxIdentity),
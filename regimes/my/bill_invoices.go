@@ -0,0 +1,104 @@
+package my
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// TagConsolidated identifies consolidated e-invoices that group the
+// transactions of final consumers who did not request an individual
+// e-invoice.
+const TagConsolidated cbc.Key = "consolidated"
+
+// generalPublicName is the buyer name LHDN expects on consolidated
+// e-invoices.
+const generalPublicName = "General Public"
+
+var invoiceTags = &tax.TagSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*cbc.Definition{
+		{
+			Key: TagConsolidated,
+			Name: i18n.String{
+				i18n.EN: "Consolidated e-Invoice",
+				i18n.MS: "e-Invois Disatukan",
+			},
+			Desc: i18n.String{
+				i18n.EN: "Monthly summary of transactions with final consumers issued to the general public.",
+			},
+		},
+	},
+}
+
+// normalizeBillInvoice prepares consolidated e-invoices by adding the
+// general public as the customer when none was provided, and classifying
+// line items with the consolidated code.
+func normalizeBillInvoice(inv *bill.Invoice) {
+	if inv == nil || !inv.HasTags(TagConsolidated) {
+		return
+	}
+	if inv.Customer == nil {
+		inv.Customer = &org.Party{
+			Name: generalPublicName,
+			TaxID: &tax.Identity{
+				Country: CountryCode,
+				Code:    TINGeneralPublic,
+			},
+		}
+	}
+	for _, line := range inv.Lines {
+		if line == nil || line.Item == nil || line.Item.Ext.Has(ExtKeyClassification) {
+			continue
+		}
+		line.Item.Ext = line.Item.Ext.Set(ExtKeyClassification, ClassificationConsolidated)
+	}
+}
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+				),
+			),
+			rules.When(is.Func("consolidated", isConsolidatedInvoice),
+				rules.Field("customer",
+					rules.Field("tax_id",
+						rules.Field("code",
+							rules.Assert("02",
+								fmt.Sprintf("consolidated invoice customer tax ID code must be '%s'", TINGeneralPublic),
+								is.In(any(TINGeneralPublic)),
+							),
+						),
+					),
+				),
+				rules.Field("lines",
+					rules.Each(
+						rules.Field("item",
+							rules.Field("ext",
+								rules.Assert("03",
+									fmt.Sprintf("consolidated invoice items must use classification '%s'", ClassificationConsolidated),
+									tax.ExtensionsHasCodes(ExtKeyClassification, ClassificationConsolidated),
+								),
+							),
+						),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isConsolidatedInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && inv.HasTags(TagConsolidated)
+}
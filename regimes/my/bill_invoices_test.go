@@ -0,0 +1,167 @@
+package my_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(my.CountryCode),
+		Code:      "INV-2025-001",
+		IssueDate: cal.MakeDate(2025, 6, 1),
+		Supplier: &org.Party{
+			Name: "Pembekal Sdn. Bhd.",
+			TaxID: &tax.Identity{
+				Country: "MY",
+				Code:    "C2584563200",
+			},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				my.ExtKeyMSIC: "62010",
+			}),
+		},
+		Customer: &org.Party{
+			Name: "Pelanggan Bhd.",
+			TaxID: &tax.Identity{
+				Country: "MY",
+				Code:    "C4890799050",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Perkhidmatan perundingan IT",
+					Price: num.NewAmount(1000, 0),
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						my.ExtKeyClassification: "022",
+					}),
+				},
+				Taxes: tax.Set{
+					{Category: my.TaxCategorySVT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name  string
+		date  cal.Date
+		combo *tax.Combo
+		tax   string
+	}{
+		{
+			name:  "service tax",
+			date:  cal.MakeDate(2025, 6, 1),
+			combo: &tax.Combo{Category: my.TaxCategorySVT, Rate: tax.RateGeneral},
+			tax:   "800.00",
+		},
+		{
+			name:  "service tax before 2024",
+			date:  cal.MakeDate(2023, 6, 1),
+			combo: &tax.Combo{Category: my.TaxCategorySVT, Rate: tax.RateGeneral},
+			tax:   "600.00",
+		},
+		{
+			name:  "reduced service tax",
+			date:  cal.MakeDate(2025, 6, 1),
+			combo: &tax.Combo{Category: my.TaxCategorySVT, Rate: tax.RateReduced},
+			tax:   "600.00",
+		},
+		{
+			name:  "sales tax",
+			date:  cal.MakeDate(2025, 6, 1),
+			combo: &tax.Combo{Category: tax.CategoryST, Rate: tax.RateGeneral},
+			tax:   "1000.00",
+		},
+		{
+			name:  "reduced sales tax",
+			date:  cal.MakeDate(2025, 6, 1),
+			combo: &tax.Combo{Category: tax.CategoryST, Rate: tax.RateReduced},
+			tax:   "500.00",
+		},
+		{
+			name:  "exempt",
+			date:  cal.MakeDate(2025, 6, 1),
+			combo: &tax.Combo{Category: tax.CategoryST, Key: tax.KeyExempt},
+			tax:   "0.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0] = tt.combo
+			require.NoError(t, inv.Calculate())
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "MYR", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-BILL-INVOICE-01]")
+	})
+	t.Run("invalid MSIC", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.Ext = tax.ExtensionsOf(cbc.CodeMap{my.ExtKeyMSIC: "6201"})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-ORG-PARTY-01]")
+	})
+	t.Run("invalid classification", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Ext = tax.ExtensionsOf(cbc.CodeMap{my.ExtKeyClassification: "099"})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-ORG-ITEM-01]")
+	})
+}
+
+func TestConsolidatedInvoice(t *testing.T) {
+	t.Run("normalizes customer and classification", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(my.TagConsolidated)
+		inv.Customer = nil
+		inv.Lines[0].Item.Ext = tax.Extensions{}
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		require.NotNil(t, inv.Customer)
+		assert.Equal(t, "General Public", inv.Customer.Name)
+		assert.Equal(t, my.TINGeneralPublic, inv.Customer.TaxID.Code)
+		assert.Equal(t, my.ClassificationConsolidated, inv.Lines[0].Item.Ext.Get(my.ExtKeyClassification))
+	})
+	t.Run("customer with own TIN", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(my.TagConsolidated)
+		inv.Lines[0].Item.Ext = tax.Extensions{}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-BILL-INVOICE-02]")
+	})
+	t.Run("other classification", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(my.TagConsolidated)
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-MY-BILL-INVOICE-03]")
+	})
+}
@@ -0,0 +1,243 @@
+package my
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyMSIC           cbc.Key = "my-msic"
+	ExtKeyClassification cbc.Key = "my-classification"
+)
+
+// ClassificationConsolidated is the item classification code that must be
+// used on every line of a consolidated e-invoice.
+const ClassificationConsolidated cbc.Code = "004"
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyMSIC,
+		Name: i18n.String{
+			i18n.EN: "MSIC Code",
+			i18n.MS: "Kod MSIC",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Malaysia Standard Industrial Classification (MSIC) 2008 code of the
+				party's main business activity, set on the supplier's ~ext~. Parties
+				without a relevant activity may use ~00000~.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("LHDN - MSIC Codes"),
+				URL:   "https://sdk.myinvois.hasil.gov.my/codes/msic-codes/",
+			},
+		},
+		Pattern: `^\d{5}$`,
+	},
+	{
+		Key: ExtKeyClassification,
+		Name: i18n.String{
+			i18n.EN: "Classification Code",
+			i18n.MS: "Kod Klasifikasi",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Category of the products or services being billed, set on each line
+				item's ~ext~. Consolidated e-invoices must use ~004~ on every line,
+				which GOBL sets automatically for invoices with the ~consolidated~ tag.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("LHDN - Classification Codes"),
+				URL:   "https://sdk.myinvois.hasil.gov.my/codes/classification-codes/",
+			},
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "001",
+				Name: i18n.NewString("Breastfeeding equipment"),
+			},
+			{
+				Code: "002",
+				Name: i18n.NewString("Child care centres and kindergartens fees"),
+			},
+			{
+				Code: "003",
+				Name: i18n.NewString("Computer, smartphone or tablet"),
+			},
+			{
+				Code: ClassificationConsolidated,
+				Name: i18n.NewString("Consolidated e-Invoice"),
+			},
+			{
+				Code: "005",
+				Name: i18n.NewString("Construction materials"),
+			},
+			{
+				Code: "006",
+				Name: i18n.NewString("Disbursement"),
+			},
+			{
+				Code: "007",
+				Name: i18n.NewString("Donation"),
+			},
+			{
+				Code: "008",
+				Name: i18n.NewString("e-Commerce - e-Invoice to buyer / purchaser"),
+			},
+			{
+				Code: "009",
+				Name: i18n.NewString("e-Commerce - Self-billed e-Invoice to seller, logistics, etc."),
+			},
+			{
+				Code: "010",
+				Name: i18n.NewString("Education fees"),
+			},
+			{
+				Code: "011",
+				Name: i18n.NewString("Goods on consignment (Consignor)"),
+			},
+			{
+				Code: "012",
+				Name: i18n.NewString("Goods on consignment (Consignee)"),
+			},
+			{
+				Code: "013",
+				Name: i18n.NewString("Gym membership"),
+			},
+			{
+				Code: "014",
+				Name: i18n.NewString("Insurance - Education and medical benefits"),
+			},
+			{
+				Code: "015",
+				Name: i18n.NewString("Insurance - Takaful or life insurance"),
+			},
+			{
+				Code: "016",
+				Name: i18n.NewString("Interest and financing expenses"),
+			},
+			{
+				Code: "017",
+				Name: i18n.NewString("Internet subscription"),
+			},
+			{
+				Code: "018",
+				Name: i18n.NewString("Land and building"),
+			},
+			{
+				Code: "019",
+				Name: i18n.NewString("Medical examination for learning disabilities and early intervention or rehabilitation treatments of learning disabilities"),
+			},
+			{
+				Code: "020",
+				Name: i18n.NewString("Medical examination or vaccination expenses"),
+			},
+			{
+				Code: "021",
+				Name: i18n.NewString("Medical expenses for serious diseases"),
+			},
+			{
+				Code: "022",
+				Name: i18n.NewString("Others"),
+			},
+			{
+				Code: "023",
+				Name: i18n.NewString("Petroleum operations"),
+			},
+			{
+				Code: "024",
+				Name: i18n.NewString("Private retirement scheme or deferred annuity scheme"),
+			},
+			{
+				Code: "025",
+				Name: i18n.NewString("Motor vehicle"),
+			},
+			{
+				Code: "026",
+				Name: i18n.NewString("Subscription of books, journals, magazines, newspapers or other similar publications"),
+			},
+			{
+				Code: "027",
+				Name: i18n.NewString("Reimbursement"),
+			},
+			{
+				Code: "028",
+				Name: i18n.NewString("Rental of motor vehicle"),
+			},
+			{
+				Code: "029",
+				Name: i18n.NewString("EV charging facilities"),
+			},
+			{
+				Code: "030",
+				Name: i18n.NewString("Repair and maintenance"),
+			},
+			{
+				Code: "031",
+				Name: i18n.NewString("Research and development"),
+			},
+			{
+				Code: "032",
+				Name: i18n.NewString("Foreign income"),
+			},
+			{
+				Code: "033",
+				Name: i18n.NewString("Self-billed - Betting and gaming"),
+			},
+			{
+				Code: "034",
+				Name: i18n.NewString("Self-billed - Importation of goods"),
+			},
+			{
+				Code: "035",
+				Name: i18n.NewString("Self-billed - Importation of services"),
+			},
+			{
+				Code: "036",
+				Name: i18n.NewString("Self-billed - Others"),
+			},
+			{
+				Code: "037",
+				Name: i18n.NewString("Self-billed - Monetary payment to agents, dealers or distributors"),
+			},
+			{
+				Code: "038",
+				Name: i18n.NewString("Sports equipment, facilities, competitions or training"),
+			},
+			{
+				Code: "039",
+				Name: i18n.NewString("Supporting equipment for disabled person"),
+			},
+			{
+				Code: "040",
+				Name: i18n.NewString("Voluntary contribution to approved provident fund"),
+			},
+			{
+				Code: "041",
+				Name: i18n.NewString("Dental examination or treatment"),
+			},
+			{
+				Code: "042",
+				Name: i18n.NewString("Fertility treatment"),
+			},
+			{
+				Code: "043",
+				Name: i18n.NewString("Treatment and home care nursing, daycare centres and residential care centers"),
+			},
+			{
+				Code: "044",
+				Name: i18n.NewString("Vouchers, gift cards, loyalty points, etc."),
+			},
+			{
+				Code: "045",
+				Name: i18n.NewString("Self-billed - Non-monetary payment to agents, dealers or distributors"),
+			},
+		},
+	},
+}
@@ -0,0 +1,99 @@
+// Package my provides the tax regime definition for Malaysia.
+package my
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Malaysia.
+const CountryCode = "MY"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("my", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgIdentityRules(),
+		orgItemRules(),
+		orgPartyRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeBillInvoice),
+		norm.For(normalizeOrgIdentity),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:  CountryCode,
+		Currency: currency.MYR,
+		Name: i18n.String{
+			i18n.EN: "Malaysia",
+			i18n.MS: "Malaysia",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Malaysia levies the Sales and Service Tax (SST) administered by the Royal
+				Malaysian Customs Department (JKDM), made up of two separate single-stage
+				taxes: a sales tax charged on taxable goods manufactured in or imported
+				into Malaysia, and a service tax charged on prescribed taxable services.
+				Businesses register separately for each tax once they exceed the
+				applicable thresholds.
+
+				Every taxpayer is identified by the Tax Identification Number (TIN)
+				issued by the Inland Revenue Board (LHDN), prefixed with letters that
+				indicate the type of taxpayer, such as ~IG~ for individuals or ~C~ for
+				companies. The TIN is complemented by the Business Registration Number
+				(BRN) issued by the Companies Commission (SSM), or the MyKad number
+				(NRIC), passport or armed forces number for individuals.
+
+				LHDN's MyInvois e-invoicing mandate is being phased in from August 2024
+				based on annual turnover. Items must be classified using the MyInvois
+				classification codes, suppliers must declare their MSIC industry code,
+				and transactions with final consumers that do not request an e-invoice
+				may be grouped in a monthly consolidated e-invoice issued to the general
+				public using the ~consolidated~ tag. See the ~my-myinvois-v1~ addon for
+				the complete set of MyInvois requirements.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("LHDN - e-Invoice Guideline"),
+				URL:   "https://www.hasil.gov.my/en/e-invoice/",
+			},
+			{
+				Title: i18n.NewString("MySST - Royal Malaysian Customs Department"),
+				URL:   "https://mysst.customs.gov.my/",
+			},
+		},
+		TimeZone:   "Asia/Kuala_Lumpur",
+		Tags:       []*tax.TagSet{invoiceTags},
+		Extensions: extensions,
+		Identities: identityTypeDefinitions,
+		Scenarios: []*tax.ScenarioSet{
+			bill.InvoiceScenarios(),
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package my
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Identity types used alongside the TIN to identify parties, named after the
+// scheme IDs used by MyInvois.
+const (
+	// IdentityTypeBRN is the Business Registration Number issued by the
+	// Companies Commission of Malaysia (SSM).
+	IdentityTypeBRN cbc.Code = "BRN"
+	// IdentityTypeNRIC is the MyKad or MyPR identity card number for
+	// Malaysian citizens and permanent residents.
+	IdentityTypeNRIC cbc.Code = "NRIC"
+	// IdentityTypePassport is the passport number of foreign individuals.
+	IdentityTypePassport cbc.Code = "PASSPORT"
+	// IdentityTypeArmy is the identification number of armed forces
+	// personnel.
+	IdentityTypeArmy cbc.Code = "ARMY"
+)
+
+var identityTypeDefinitions = []*cbc.Definition{
+	{
+		Code: IdentityTypeBRN,
+		Name: i18n.String{
+			i18n.EN: "Business Registration Number",
+			i18n.MS: "Nombor Pendaftaran Perniagaan",
+		},
+		Desc: i18n.String{
+			i18n.EN: "Registration number issued by the Companies Commission of Malaysia (SSM), using the 12 digit format introduced in 2019 or the legacy format.",
+		},
+	},
+	{
+		Code: IdentityTypeNRIC,
+		Name: i18n.String{
+			i18n.EN: "Identity Card Number",
+			i18n.MS: "Nombor Kad Pengenalan",
+		},
+		Desc: i18n.String{
+			i18n.EN: "12 digit MyKad or MyPR number, starting with the holder's date of birth.",
+		},
+	},
+	{
+		Code: IdentityTypePassport,
+		Name: i18n.String{
+			i18n.EN: "Passport Number",
+			i18n.MS: "Nombor Pasport",
+		},
+	},
+	{
+		Code: IdentityTypeArmy,
+		Name: i18n.String{
+			i18n.EN: "Armed Forces Number",
+			i18n.MS: "Nombor Tentera",
+		},
+	},
+}
+
+var (
+	// brnRegexps cover the 12 digit format (year, entity type and sequence)
+	// and the legacy company and business registration numbers.
+	brnRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`^(19|20)\d{2}0[1-6]\d{6}$`),
+		regexp.MustCompile(`^\d{1,7}[A-Z]$`),
+		regexp.MustCompile(`^[A-Z]{2}\d{7}[A-Z]$`),
+	}
+	nricRegexp = regexp.MustCompile(`^\d{12}$`)
+)
+
+func orgIdentityRules() *rules.Set {
+	return rules.For(new(org.Identity),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				org.IdentityTypeIn(IdentityTypeBRN),
+				rules.Field("code",
+					rules.Assert("01", "invalid business registration number",
+						is.Func("valid BRN", isValidBRN),
+					),
+				),
+			),
+			rules.When(
+				org.IdentityTypeIn(IdentityTypeNRIC),
+				rules.Field("code",
+					rules.Assert("02", "invalid identity card number",
+						is.Func("valid NRIC", isValidNRIC),
+					),
+				),
+			),
+		),
+	)
+}
+
+// normalizeOrgIdentity removes the separators commonly used when writing
+// registration and identity card numbers, such as "123456-A" or
+// "900101-14-5678".
+func normalizeOrgIdentity(id *org.Identity) {
+	if id == nil {
+		return
+	}
+	switch id.Type {
+	case IdentityTypeBRN, IdentityTypeNRIC:
+		id.Code = cbc.NormalizeAlphanumericalCode(id.Code)
+	}
+}
+
+func isValidBRN(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	for _, re := range brnRegexps {
+		if re.MatchString(code.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidNRIC checks the identity card number is made of 12 digits, the
+// first six being a valid date of birth.
+func isValidNRIC(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !nricRegexp.MatchString(val) {
+		return false
+	}
+	_, err := time.Parse("060102", val[:6])
+	return err == nil
+}
@@ -0,0 +1,66 @@
+package my_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOrgIdentity(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  cbc.Code
+		code cbc.Code
+		want cbc.Code
+	}{
+		{name: "legacy BRN", typ: my.IdentityTypeBRN, code: "123456-a", want: "123456A"},
+		{name: "NRIC", typ: my.IdentityTypeNRIC, code: "900101-14-5678", want: "900101145678"},
+		{name: "passport untouched", typ: my.IdentityTypePassport, code: "A1234-567", want: "A1234-567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := &org.Identity{Type: tt.typ, Code: tt.code}
+			norm.Normalize(id, tax.RegimeContext(my.CountryCode))
+			assert.Equal(t, tt.want, id.Code)
+		})
+	}
+}
+
+func TestValidateOrgIdentity(t *testing.T) {
+	opts := []rules.WithContext{
+		tax.RegimeContext(my.CountryCode),
+	}
+	tests := []struct {
+		name string
+		typ  cbc.Code
+		code cbc.Code
+		err  string
+	}{
+		{name: "BRN", typ: my.IdentityTypeBRN, code: "202001234567"},
+		{name: "legacy BRN", typ: my.IdentityTypeBRN, code: "123456A"},
+		{name: "legacy business BRN", typ: my.IdentityTypeBRN, code: "JM0563214A"},
+		{name: "BRN bad entity type", typ: my.IdentityTypeBRN, code: "202009234567", err: "[GOBL-MY-ORG-IDENTITY-01]"},
+		{name: "BRN bad format", typ: my.IdentityTypeBRN, code: "12345", err: "[GOBL-MY-ORG-IDENTITY-01]"},
+		{name: "NRIC", typ: my.IdentityTypeNRIC, code: "900101145678"},
+		{name: "NRIC bad date", typ: my.IdentityTypeNRIC, code: "901301145678", err: "[GOBL-MY-ORG-IDENTITY-02]"},
+		{name: "NRIC too short", typ: my.IdentityTypeNRIC, code: "90010114567", err: "[GOBL-MY-ORG-IDENTITY-02]"},
+		{name: "passport", typ: my.IdentityTypePassport, code: "A12345678"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := &org.Identity{Type: tt.typ, Code: tt.code}
+			err := rules.Validate(id, opts...)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package my
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func orgItemRules() *rules.Set {
+	return rules.For(new(org.Item),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("ext",
+				rules.Assert("01", fmt.Sprintf("item ext must define a valid '%s' code", ExtKeyClassification),
+					tax.ExtensionHasValidCode(ExtKeyClassification),
+				),
+			),
+		),
+	)
+}
@@ -0,0 +1,23 @@
+package my
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func orgPartyRules() *rules.Set {
+	return rules.For(new(org.Party),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("ext",
+				rules.Assert("01", fmt.Sprintf("party ext must define a valid '%s' code", ExtKeyMSIC),
+					tax.ExtensionHasValidCode(ExtKeyMSIC),
+				),
+			),
+		),
+	)
+}
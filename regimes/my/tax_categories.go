@@ -0,0 +1,146 @@
+package my
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+// Tax categories specific for Malaysia. Sales tax uses the global
+// ST category.
+const (
+	TaxCategorySVT cbc.Code = "SVT"
+)
+
+// sstKeys are shared by the sales and service tax categories, which are
+// either charged at a rate or exempt.
+var sstKeys = []*tax.KeyDef{
+	{
+		Key:  tax.KeyStandard,
+		Name: i18n.NewString("Standard"),
+	},
+	{
+		Key:       tax.KeyExempt,
+		Name:      i18n.NewString("Exempt"),
+		NoPercent: true,
+	},
+}
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// Sales Tax
+	//
+	{
+		Code: tax.CategoryST,
+		Name: i18n.String{
+			i18n.EN: "ST",
+			i18n.MS: "CJ",
+		},
+		Title: i18n.String{
+			i18n.EN: "Sales Tax",
+			i18n.MS: "Cukai Jualan",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Sales Tax Act 2018"),
+				URL:   "https://mysst.customs.gov.my/SSTLegislation",
+			},
+		},
+		Retained: false,
+		Keys:     sstKeys,
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.MS: "Kadar Am",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2018, 9, 1),
+						Percent: num.MakePercentage(100, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.MS: "Kadar Dikurangkan",
+				},
+				Description: i18n.String{
+					i18n.EN: "Applies to goods listed in the First Schedule of the Sales Tax (Rates of Tax) Order.",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2018, 9, 1),
+						Percent: num.MakePercentage(50, 3),
+					},
+				},
+			},
+		},
+	},
+	//
+	// Service Tax
+	//
+	{
+		Code: TaxCategorySVT,
+		Name: i18n.String{
+			i18n.EN: "SVT",
+			i18n.MS: "CP",
+		},
+		Title: i18n.String{
+			i18n.EN: "Service Tax",
+			i18n.MS: "Cukai Perkhidmatan",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Service Tax Act 2018"),
+				URL:   "https://mysst.customs.gov.my/SSTLegislation",
+			},
+		},
+		Retained: false,
+		Keys:     sstKeys,
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.MS: "Kadar Am",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2024, 3, 1),
+						Percent: num.MakePercentage(80, 3),
+					},
+					{
+						Since:   cal.NewDate(2018, 9, 1),
+						Percent: num.MakePercentage(60, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.MS: "Kadar Dikurangkan",
+				},
+				Description: i18n.String{
+					i18n.EN: "Food and beverage, telecommunications, parking and logistics services kept the original rate when the general rate increased in March 2024.",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2024, 3, 1),
+						Percent: num.MakePercentage(60, 3),
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,36 @@
+package my
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// General TINs defined by LHDN for parties that cannot provide their own,
+// such as final consumers in consolidated e-invoices or foreign parties.
+const (
+	TINGeneralPublic   cbc.Code = "EI00000000010"
+	TINForeignBuyer    cbc.Code = "EI00000000020"
+	TINForeignSupplier cbc.Code = "EI00000000030"
+	TINGovernment      cbc.Code = "EI00000000040"
+)
+
+// Tax identification numbers start with a prefix that indicates the type
+// of taxpayer (IG for individuals, C for companies, etc.) followed by up
+// to 11 digits. General TINs use the EI prefix.
+var tinRegexp = regexp.MustCompile(`^((IG|C|CS|D|E|F|FA|PT|TA|TC|TN|TR|TP|J|LE)\d{9,11}|EI\d{11})$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Malaysian tax identification number",
+					is.MatchesRegexp(tinRegexp),
+				),
+			),
+		),
+	)
+}
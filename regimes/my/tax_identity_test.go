@@ -0,0 +1,111 @@
+package my_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/regimes/my"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "upper case and strips spaces",
+			inputCode:    "c 2584563200",
+			expectedCode: "C2584563200",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "C2584563200",
+			expectedCode: "C2584563200",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "MY", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "company",
+			inputCode: "C2584563200",
+		},
+		{
+			name:      "company 11 digits",
+			inputCode: "C25845632020",
+		},
+		{
+			name:      "individual",
+			inputCode: "IG21136626090",
+		},
+		{
+			name:      "general public",
+			inputCode: my.TINGeneralPublic,
+		},
+		{
+			name:      "foreign buyer",
+			inputCode: my.TINForeignBuyer,
+		},
+		{
+			name:        "unknown prefix",
+			inputCode:   "X2584563200",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "missing prefix",
+			inputCode:   "2584563200",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "C25845632",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "general TIN too short",
+			inputCode:   "EI0000000001",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "MY", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
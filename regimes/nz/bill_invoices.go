@@ -0,0 +1,89 @@
+package nz
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Thresholds of the total amount, including GST, above which additional
+// taxable supply information is required.
+var (
+	supplierInfoThreshold  = num.MakeAmount(200, 0)
+	recipientInfoThreshold = num.MakeAmount(1000, 0)
+)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(is.Func("above supplier info threshold", invoiceAboveSupplierInfoThreshold),
+				rules.Field("supplier",
+					rules.Field("tax_id",
+						rules.Assert("01", "invoice supplier GST number is required for supplies over NZ$200", is.Present),
+						rules.Field("code",
+							rules.Assert("02", "invoice supplier GST number is required for supplies over NZ$200", is.Present),
+						),
+					),
+				),
+			),
+			rules.When(is.Func("above recipient info threshold", invoiceAboveRecipientInfoThreshold),
+				rules.Field("customer",
+					rules.Assert("03", "invoice customer is required for supplies over NZ$1,000", is.Present),
+					rules.Field("name",
+						rules.Assert("04", "invoice customer name is required for supplies over NZ$1,000", is.Present),
+					),
+					rules.Assert("05", "invoice customer identifier is required for supplies over NZ$1,000",
+						is.Func("has identifier", hasRecipientIdentifier),
+					),
+				),
+			),
+		),
+	)
+}
+
+// hasRecipientIdentifier checks for any of the identifiers accepted for
+// recipients: address, phone, email, NZBN, GST number, or other identity.
+func hasRecipientIdentifier(value any) bool {
+	p, _ := value.(*org.Party)
+	if p == nil {
+		return false
+	}
+	return len(p.Addresses) > 0 ||
+		len(p.Telephones) > 0 ||
+		len(p.Emails) > 0 ||
+		len(p.Identities) > 0 ||
+		(p.TaxID != nil && p.TaxID.Code != "")
+}
+
+func invoiceAboveSupplierInfoThreshold(value any) bool {
+	return invoiceAboveThreshold(value, supplierInfoThreshold)
+}
+
+func invoiceAboveRecipientInfoThreshold(value any) bool {
+	return invoiceAboveThreshold(value, recipientInfoThreshold)
+}
+
+// invoiceAboveThreshold checks the invoice's total with tax in New Zealand
+// dollars, converting from other currencies when an exchange rate is
+// available. Invoices without a rate will always be considered above
+// the threshold.
+func invoiceAboveThreshold(value any, threshold num.Amount) bool {
+	inv, ok := value.(*bill.Invoice)
+	if !ok || inv == nil || inv.Totals == nil {
+		return false
+	}
+	total := inv.Totals.TotalWithTax
+	if inv.Currency != currency.NZD {
+		rate := currency.MatchExchangeRate(inv.ExchangeRates, inv.Currency, currency.NZD)
+		if rate == nil {
+			return true
+		}
+		total = rate.Convert(total)
+	}
+	return total.Compare(threshold) > 0
+}
@@ -0,0 +1,90 @@
+package nz_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/nz"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(nz.CountryCode),
+		Code:      "0001",
+		IssueDate: cal.MakeDate(2024, 7, 1),
+		Supplier: &org.Party{
+			Name: "Test Supplier Ltd",
+			TaxID: &tax.Identity{
+				Country: "NZ",
+				Code:    "49091850",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(1, 0),
+				Item: &org.Item{
+					Name:  "Test Item",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryGST, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "NZD", inv.Currency.String())
+		assert.Equal(t, "15.00", inv.Totals.Tax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("low value without supplier GST number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("over NZ$200 without supplier GST number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(20000, 2)
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-NZ-BILL-INVOICE-01]")
+	})
+	t.Run("over NZ$1,000 without customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-NZ-BILL-INVOICE-03]")
+	})
+	t.Run("over NZ$1,000 without customer identifier", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		inv.Customer = &org.Party{Name: "Test Customer"}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-NZ-BILL-INVOICE-05]")
+	})
+	t.Run("over NZ$1,000 with customer NZBN", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Item.Price = num.NewAmount(100000, 2)
+		inv.Customer = &org.Party{
+			Name: "Test Customer",
+			Identities: []*org.Identity{
+				{Type: nz.IdentityTypeNZBN, Code: "9429041535134"},
+			},
+		}
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+}
@@ -0,0 +1,82 @@
+// Package nz provides the tax region definition for New Zealand.
+package nz
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for New Zealand
+const CountryCode = "NZ"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("nz", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		orgIdentityRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(normalizeTaxIdentity)),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeIdentity), // *org.Identity
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.NZD,
+		TaxScheme: tax.CategoryGST,
+		Name: i18n.String{
+			i18n.EN: "New Zealand",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Inland Revenue - Taxable supply information"),
+				URL:   "https://www.ird.govt.nz/gst/tax-invoices-for-gst/how-taxable-supply-information-works",
+			},
+		},
+		TimeZone: "Pacific/Auckland",
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				New Zealand applies a Goods and Services Tax (GST) of 15% administered by
+				Inland Revenue (IRD). Exports and the sale of going concerns are zero-rated,
+				while financial services and residential rent are exempt.
+
+				GST registered suppliers are identified by their GST number, which is the
+				same as their IRD number: 8 or 9 digits with a check digit. Businesses are
+				also identified by their New Zealand Business Number (NZBN), a 13 digit
+				GS1 Global Location Number used as the participant identifier in the A-NZ
+				Peppol network under ISO 6523 scheme 0088.
+
+				Since April 2023 suppliers must provide taxable supply information instead
+				of tax invoices. Supplies over NZ$200 must include the supplier's name and GST
+				number, the date, a description and the amounts, and supplies over NZ$1,000
+				must also include the recipient's name and an identifier such as their
+				address, phone number, email, NZBN, or GST number. Credit and debit notes
+				are used to correct previous invoices.
+			`),
+		},
+		Identities: identityDefinitions, // org_identities.go
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+			},
+		},
+		Categories: taxCategories(),
+	}
+}
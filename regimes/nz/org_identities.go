@@ -0,0 +1,81 @@
+package nz
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+const (
+	// IdentityTypeNZBN represents the New Zealand Business Number.
+	IdentityTypeNZBN cbc.Code = "NZBN"
+)
+
+// NZBNs are GS1 Global Location Numbers allocated with the "94" prefix.
+var nzbnRegexp = regexp.MustCompile(`^94\d{11}$`)
+
+var identityDefinitions = []*cbc.Definition{
+	{
+		Code: IdentityTypeNZBN,
+		Name: i18n.String{
+			i18n.EN: "New Zealand Business Number (NZBN)",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				The New Zealand Business Number (NZBN) is a 13 digit GS1 Global Location
+				Number issued to businesses in New Zealand. It is used as the participant
+				identifier in the A-NZ Peppol network under ISO 6523 scheme 0088.
+			`),
+		},
+	},
+}
+
+func normalizeIdentity(id *org.Identity) {
+	if id == nil || id.Type != IdentityTypeNZBN {
+		return
+	}
+	code := strings.ToUpper(id.Code.String())
+	code = tax.IdentityCodeBadCharsRegexp.ReplaceAllString(code, "")
+	id.Code = cbc.Code(strings.TrimPrefix(code, string(l10n.NZ)))
+}
+
+func orgIdentityRules() *rules.Set {
+	return rules.For(new(org.Identity),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				org.IdentityTypeIn(IdentityTypeNZBN),
+				rules.Field("code",
+					rules.Assert("01", "identity code for type NZBN must be valid",
+						is.Func("valid NZBN", isValidNZBN),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isValidNZBN(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || !nzbnRegexp.MatchString(code.String()) {
+		return false
+	}
+	val := code.String()
+	sum := 0
+	for i := range 12 {
+		d := int(val[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(val[12]-'0') == (10-sum%10)%10
+}
@@ -0,0 +1,30 @@
+package nz_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/nz"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgIdentityNZBN(t *testing.T) {
+	ctx := tax.RegimeContext(nz.CountryCode)
+	t.Run("normalize and validate", func(t *testing.T) {
+		id := &org.Identity{Type: nz.IdentityTypeNZBN, Code: "94290 41535 134"}
+		norm.Normalize(id, ctx)
+		assert.Equal(t, "9429041535134", id.Code.String())
+		assert.NoError(t, rules.Validate(id, ctx))
+	})
+	t.Run("bad check digit", func(t *testing.T) {
+		id := &org.Identity{Type: nz.IdentityTypeNZBN, Code: "9429041535135"}
+		assert.ErrorContains(t, rules.Validate(id, ctx), "[GOBL-NZ-ORG-IDENTITY-01]")
+	})
+	t.Run("bad prefix", func(t *testing.T) {
+		id := &org.Identity{Type: nz.IdentityTypeNZBN, Code: "1234567890128"}
+		assert.ErrorContains(t, rules.Validate(id, ctx), "[GOBL-NZ-ORG-IDENTITY-01]")
+	})
+}
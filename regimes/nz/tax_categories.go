@@ -0,0 +1,60 @@
+package nz
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxCategories() []*tax.CategoryDef {
+	return []*tax.CategoryDef{
+		// GST
+		{
+			Code: tax.CategoryGST,
+			Name: i18n.String{
+				i18n.EN: "GST",
+			},
+			Title: i18n.String{
+				i18n.EN: "Goods and Services Tax",
+			},
+			Sources: []*cbc.Source{
+				{
+					Title: i18n.String{
+						i18n.EN: "Goods and Services Tax Act 1985",
+					},
+					URL: "https://www.legislation.govt.nz/act/public/1985/0141/latest/whole.html",
+				},
+			},
+			Retained: false,
+			Keys:     tax.GlobalGSTKeys(),
+			Rates: []*tax.RateDef{
+				{
+					Keys: []cbc.Key{tax.KeyStandard},
+					Rate: tax.RateGeneral,
+					Name: i18n.String{
+						i18n.EN: "General rate",
+					},
+					Description: i18n.String{
+						i18n.EN: "Applies to most supplies of goods and services in New Zealand, unless they are zero-rated or exempt.",
+					},
+					Values: []*tax.RateValueDef{
+						{
+							Since:   cal.NewDate(2010, 10, 1),
+							Percent: num.MakePercentage(150, 3),
+						},
+						{
+							Since:   cal.NewDate(1989, 7, 1),
+							Percent: num.MakePercentage(125, 3),
+						},
+						{
+							Since:   cal.NewDate(1986, 10, 1),
+							Percent: num.MakePercentage(100, 3),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package nz
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// GST numbers are the same as IRD numbers, with 8 or 9 digits where the
+// last is a check digit. Eight digit numbers are padded with a leading
+// zero.
+//
+// Reference: https://www.ird.govt.nz/-/media/project/ir/home/documents/digital-service-providers/software-providers/payroll-calculations-business-rules-specifications/2024/payroll-calculations-and-business-rules-specification-2024-v1-0.pdf
+
+var (
+	irdRegexp           = regexp.MustCompile(`^\d{9}$`)
+	irdPrimaryWeights   = []int{3, 2, 7, 6, 5, 4, 3, 2}
+	irdSecondaryWeights = []int{7, 4, 3, 2, 5, 2, 7, 6}
+)
+
+const (
+	irdMin = 10000000
+	irdMax = 150000000
+)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid New Zealand GST (IRD) number",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+			),
+		),
+	)
+}
+
+// normalizeTaxIdentity pads 8 digit IRD numbers with a leading zero.
+func normalizeTaxIdentity(tID *tax.Identity) {
+	if tID == nil {
+		return
+	}
+	tax.NormalizeIdentity(tID)
+	if len(tID.Code) == 8 {
+		tID.Code = cbc.Code("0" + tID.Code.String())
+	}
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	return validateTaxCode(code) == nil
+}
+
+func validateTaxCode(code cbc.Code) error {
+	val := code.String()
+	if !irdRegexp.MatchString(val) {
+		return errors.New("invalid format")
+	}
+	n := 0
+	for _, c := range strings.TrimLeft(val, "0") {
+		n = n*10 + int(c-'0')
+	}
+	if n < irdMin || n > irdMax {
+		return errors.New("out of range")
+	}
+	check := int(val[8] - '0')
+	cd := irdCheckDigit(val[:8], irdPrimaryWeights)
+	if cd == 10 {
+		cd = irdCheckDigit(val[:8], irdSecondaryWeights)
+	}
+	if cd != check {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
+func irdCheckDigit(base string, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(base[i]-'0') * w
+	}
+	r := sum % 11
+	if r == 0 {
+		return 0
+	}
+	return 11 - r
+}
@@ -0,0 +1,107 @@
+package nz_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/nz"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "adds leading zero",
+			inputCode:    "49-091-850",
+			expectedCode: "049091850",
+		},
+		{
+			name:         "strips NZ prefix and spaces",
+			inputCode:    "NZ 136 410 132",
+			expectedCode: "136410132",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "136410132",
+			expectedCode: "136410132",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "NZ", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "primary weights",
+			inputCode: "049091850",
+		},
+		{
+			name:      "secondary weights",
+			inputCode: "035901981",
+		},
+		{
+			name:      "nine digits",
+			inputCode: "136410132",
+		},
+		{
+			name:      "empty",
+			inputCode: "",
+		},
+		{
+			name:        "bad checksum",
+			inputCode:   "136410133",
+			expectedErr: "[GOBL-NZ-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "out of range",
+			inputCode:   "009125568",
+			expectedErr: "[GOBL-NZ-TAX-IDENTITY-01]",
+		},
+		{
+			name:        "unpadded",
+			inputCode:   "49091850",
+			expectedErr: "[GOBL-NZ-TAX-IDENTITY-01]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "NZ", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package pe_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/pe"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(pe.CountryCode),
+		Series:    "F001",
+		Code:      "123",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Proveedor S.A.C.",
+			TaxID: &tax.Identity{
+				Country: "PE",
+				Code:    "20100079705",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Cliente S.A.",
+			TaxID: &tax.Identity{
+				Country: "PE",
+				Code:    "20600112148",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Servicio de consultoría",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		tax  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2024, 6, 1),
+			tax:  "180.00",
+		},
+		{
+			name: "general before March 2011",
+			date: cal.MakeDate(2010, 6, 1),
+			tax:  "190.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			require.NoError(t, inv.Calculate())
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceScenarios(t *testing.T) {
+	t.Run("boleta", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Series = "B001"
+		inv.SetTags(tax.TagSimplified)
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		assert.Equal(t, "03", inv.Tax.Ext.Get(pe.ExtKeyDocType).String())
+	})
+}
+
+func TestTaxComboAffectation(t *testing.T) {
+	tests := []struct {
+		key  cbc.Key
+		code cbc.Code
+	}{
+		{key: tax.KeyExempt, code: pe.IGVAffectationExempt},
+		{key: tax.KeyOutsideScope, code: pe.IGVAffectationUnaffected},
+		{key: tax.KeyExport, code: pe.IGVAffectationExport},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key.String(), func(t *testing.T) {
+			inv := validInvoice()
+			inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tt.key}
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			assert.Equal(t, tt.code, inv.Lines[0].Taxes[0].Ext.Get(pe.ExtKeyIGVAffectation))
+		})
+	}
+	t.Run("explicit code kept", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes[0].Ext = tax.ExtensionsOf(cbc.CodeMap{pe.ExtKeyIGVAffectation: "13"})
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		assert.Equal(t, "13", inv.Lines[0].Taxes[0].Ext.Get(pe.ExtKeyIGVAffectation).String())
+	})
+	t.Run("invalid code", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes[0].Ext = tax.ExtensionsOf(cbc.CodeMap{pe.ExtKeyIGVAffectation: "99"})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-PE-TAX-COMBO-02]")
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "PEN", inv.Currency.String())
+		assert.Equal(t, "01", inv.Tax.Ext.Get(pe.ExtKeyDocType).String())
+		assert.Equal(t, "10", inv.Lines[0].Taxes[0].Ext.Get(pe.ExtKeyIGVAffectation).String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-PE-BILL-INVOICE-01]")
+	})
+	t.Run("missing customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-PE-BILL-INVOICE-04]")
+	})
+}
+
+func TestInvoiceCorrection(t *testing.T) {
+	original := func(t *testing.T) *bill.Invoice {
+		t.Helper()
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, rules.Validate(inv))
+		return inv
+	}
+
+	t.Run("credit note", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(
+			bill.Credit,
+			bill.WithReason("Anulación de la operación"),
+			bill.WithExtension(pe.ExtKeyCreditCode, "01"),
+		)
+		require.NoError(t, err)
+		require.NoError(t, cn.Calculate())
+		require.NoError(t, rules.Validate(cn))
+		assert.Equal(t, "07", cn.Tax.Ext.Get(pe.ExtKeyDocType).String())
+		assert.Equal(t, "01", cn.Preceding[0].Ext.Get(pe.ExtKeyCreditCode).String())
+	})
+	t.Run("debit note", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(
+			bill.Debit,
+			bill.WithReason("Intereses por mora"),
+			bill.WithExtension(pe.ExtKeyDebitCode, "01"),
+		)
+		require.NoError(t, err)
+		require.NoError(t, cn.Calculate())
+		require.NoError(t, rules.Validate(cn))
+		assert.Equal(t, "08", cn.Tax.Ext.Get(pe.ExtKeyDocType).String())
+	})
+	t.Run("credit note with debit code", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(
+			bill.Credit,
+			bill.WithReason("Descuento"),
+			bill.WithExtension(pe.ExtKeyDebitCode, "02"),
+		)
+		require.NoError(t, err)
+		require.NoError(t, cn.Calculate())
+		assert.ErrorContains(t, rules.Validate(cn), "[GOBL-PE-BILL-INVOICE-10]")
+	})
+	t.Run("missing reason", func(t *testing.T) {
+		cn := original(t)
+		err := cn.Correct(bill.Credit, bill.WithExtension(pe.ExtKeyCreditCode, "01"))
+		assert.ErrorContains(t, err, "reason")
+	})
+}
@@ -0,0 +1,374 @@
+package pe
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyDocType        cbc.Key = "pe-sunat-doc-type"
+	ExtKeyIGVAffectation cbc.Key = "pe-sunat-igv-affectation"
+	ExtKeyCreditCode     cbc.Key = "pe-sunat-credit-code"
+	ExtKeyDebitCode      cbc.Key = "pe-sunat-debit-code"
+)
+
+// IGV affectation codes applied by default from the tax combo key.
+const (
+	IGVAffectationTaxed      cbc.Code = "10"
+	IGVAffectationExempt     cbc.Code = "20"
+	IGVAffectationUnaffected cbc.Code = "30"
+	IGVAffectationExport     cbc.Code = "40"
+)
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyDocType,
+		Name: i18n.String{
+			i18n.EN: "Document Type",
+			i18n.ES: "Tipo de Documento",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Document type from SUNAT catálogo 01, set automatically from the
+				invoice type and tags.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "01",
+				Name: i18n.String{
+					i18n.EN: "Invoice",
+					i18n.ES: "Factura",
+				},
+			},
+			{
+				Code: "03",
+				Name: i18n.String{
+					i18n.EN: "Sales receipt",
+					i18n.ES: "Boleta de venta",
+				},
+			},
+			{
+				Code: "07",
+				Name: i18n.String{
+					i18n.EN: "Credit note",
+					i18n.ES: "Nota de crédito",
+				},
+			},
+			{
+				Code: "08",
+				Name: i18n.String{
+					i18n.EN: "Debit note",
+					i18n.ES: "Nota de débito",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyIGVAffectation,
+		Name: i18n.String{
+			i18n.EN: "IGV Affectation Type",
+			i18n.ES: "Tipo de Afectación del IGV",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Type of IGV affectation from SUNAT catálogo 07. When not provided
+				it is determined from the tax combo key: ~10~ for standard, ~20~
+				for exempt, ~30~ for outside scope, and ~40~ for exports.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "10",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Onerous operation",
+					i18n.ES: "Gravado - Operación onerosa",
+				},
+			},
+			{
+				Code: "11",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Withdrawal as prize",
+					i18n.ES: "Gravado - Retiro por premio",
+				},
+			},
+			{
+				Code: "12",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Withdrawal as donation",
+					i18n.ES: "Gravado - Retiro por donación",
+				},
+			},
+			{
+				Code: "13",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Withdrawal",
+					i18n.ES: "Gravado - Retiro",
+				},
+			},
+			{
+				Code: "14",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Withdrawal for advertising",
+					i18n.ES: "Gravado - Retiro por publicidad",
+				},
+			},
+			{
+				Code: "15",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Bonuses",
+					i18n.ES: "Gravado - Bonificaciones",
+				},
+			},
+			{
+				Code: "16",
+				Name: i18n.String{
+					i18n.EN: "Taxed - Withdrawal for delivery to workers",
+					i18n.ES: "Gravado - Retiro por entrega a trabajadores",
+				},
+			},
+			{
+				Code: "17",
+				Name: i18n.String{
+					i18n.EN: "Taxed - IVAP",
+					i18n.ES: "Gravado - IVAP",
+				},
+			},
+			{
+				Code: "20",
+				Name: i18n.String{
+					i18n.EN: "Exempt - Onerous operation",
+					i18n.ES: "Exonerado - Operación onerosa",
+				},
+			},
+			{
+				Code: "21",
+				Name: i18n.String{
+					i18n.EN: "Exempt - Free transfer",
+					i18n.ES: "Exonerado - Transferencia gratuita",
+				},
+			},
+			{
+				Code: "30",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Onerous operation",
+					i18n.ES: "Inafecto - Operación onerosa",
+				},
+			},
+			{
+				Code: "31",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal as bonus",
+					i18n.ES: "Inafecto - Retiro por bonificación",
+				},
+			},
+			{
+				Code: "32",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal",
+					i18n.ES: "Inafecto - Retiro",
+				},
+			},
+			{
+				Code: "33",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal of medical samples",
+					i18n.ES: "Inafecto - Retiro por muestras médicas",
+				},
+			},
+			{
+				Code: "34",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal by collective agreement",
+					i18n.ES: "Inafecto - Retiro por convenio colectivo",
+				},
+			},
+			{
+				Code: "35",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal as prize",
+					i18n.ES: "Inafecto - Retiro por premio",
+				},
+			},
+			{
+				Code: "36",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Withdrawal for advertising",
+					i18n.ES: "Inafecto - Retiro por publicidad",
+				},
+			},
+			{
+				Code: "37",
+				Name: i18n.String{
+					i18n.EN: "Unaffected - Free transfer",
+					i18n.ES: "Inafecto - Transferencia gratuita",
+				},
+			},
+			{
+				Code: "40",
+				Name: i18n.String{
+					i18n.EN: "Export of goods or services",
+					i18n.ES: "Exportación de bienes o servicios",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyCreditCode,
+		Name: i18n.String{
+			i18n.EN: "Credit Note Type",
+			i18n.ES: "Tipo de Nota de Crédito",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Credit note type from SUNAT catálogo 09, set on the preceding
+				document reference of a credit note.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "01",
+				Name: i18n.String{
+					i18n.EN: "Cancellation of the operation",
+					i18n.ES: "Anulación de la operación",
+				},
+			},
+			{
+				Code: "02",
+				Name: i18n.String{
+					i18n.EN: "Cancellation due to error in the RUC",
+					i18n.ES: "Anulación por error en el RUC",
+				},
+			},
+			{
+				Code: "03",
+				Name: i18n.String{
+					i18n.EN: "Correction of error in the description",
+					i18n.ES: "Corrección por error en la descripción",
+				},
+			},
+			{
+				Code: "04",
+				Name: i18n.String{
+					i18n.EN: "Global discount",
+					i18n.ES: "Descuento global",
+				},
+			},
+			{
+				Code: "05",
+				Name: i18n.String{
+					i18n.EN: "Item discount",
+					i18n.ES: "Descuento por ítem",
+				},
+			},
+			{
+				Code: "06",
+				Name: i18n.String{
+					i18n.EN: "Full return",
+					i18n.ES: "Devolución total",
+				},
+			},
+			{
+				Code: "07",
+				Name: i18n.String{
+					i18n.EN: "Item return",
+					i18n.ES: "Devolución por ítem",
+				},
+			},
+			{
+				Code: "08",
+				Name: i18n.String{
+					i18n.EN: "Bonus",
+					i18n.ES: "Bonificación",
+				},
+			},
+			{
+				Code: "09",
+				Name: i18n.String{
+					i18n.EN: "Decrease in value",
+					i18n.ES: "Disminución en el valor",
+				},
+			},
+			{
+				Code: "10",
+				Name: i18n.String{
+					i18n.EN: "Other concepts",
+					i18n.ES: "Otros conceptos",
+				},
+			},
+			{
+				Code: "11",
+				Name: i18n.String{
+					i18n.EN: "Adjustments to export operations",
+					i18n.ES: "Ajustes de operaciones de exportación",
+				},
+			},
+			{
+				Code: "12",
+				Name: i18n.String{
+					i18n.EN: "Adjustments subject to IVAP",
+					i18n.ES: "Ajustes afectos al IVAP",
+				},
+			},
+			{
+				Code: "13",
+				Name: i18n.String{
+					i18n.EN: "Correction of the outstanding amount or payment due dates",
+					i18n.ES: "Corrección del monto neto pendiente de pago y/o las fechas de vencimiento",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyDebitCode,
+		Name: i18n.String{
+			i18n.EN: "Debit Note Type",
+			i18n.ES: "Tipo de Nota de Débito",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Debit note type from SUNAT catálogo 10, set on the preceding
+				document reference of a debit note.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "01",
+				Name: i18n.String{
+					i18n.EN: "Late payment interest",
+					i18n.ES: "Intereses por mora",
+				},
+			},
+			{
+				Code: "02",
+				Name: i18n.String{
+					i18n.EN: "Increase in value",
+					i18n.ES: "Aumento en el valor",
+				},
+			},
+			{
+				Code: "03",
+				Name: i18n.String{
+					i18n.EN: "Penalties or other concepts",
+					i18n.ES: "Penalidades u otros conceptos",
+				},
+			},
+			{
+				Code: "11",
+				Name: i18n.String{
+					i18n.EN: "Adjustments to export operations",
+					i18n.ES: "Ajustes de operaciones de exportación",
+				},
+			},
+			{
+				Code: "12",
+				Name: i18n.String{
+					i18n.EN: "Adjustments subject to IVAP",
+					i18n.ES: "Ajustes afectos al IVAP",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,81 @@
+// Package pe provides the tax regime definition for Peru.
+package pe
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Peru.
+const CountryCode = "PE"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("pe", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxComboRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+	norm.RegisterWithGuard(is.InContext(tax.RegimeIn(CountryCode)),
+		norm.For(normalizeTaxCombo),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.PEN,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Peru",
+			i18n.ES: "Perú",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Peru's tax system is administered by SUNAT (Superintendencia Nacional
+				de Aduanas y de Administración Tributaria). The IGV (Impuesto General
+				a las Ventas) is charged on the sale of goods and services together
+				with the IPM (Impuesto de Promoción Municipal), which GOBL combines
+				into a single general rate.
+
+				Taxpayers are identified by their 11 digit RUC (Registro Único de
+				Contribuyentes), which includes a modulus 11 check digit.
+
+				Electronic invoicing (SEE, Sistema de Emisión Electrónica) uses the
+				SUNAT catalogues for coded values. The document type from catálogo 01
+				is set in the ~pe-sunat-doc-type~ extension: ~01~ for facturas, ~03~
+				for boletas issued with the ~simplified~ tag, and ~07~ and ~08~ for
+				credit and debit notes. Each IGV line declares its affectation type
+				from catálogo 07 in the ~pe-sunat-igv-affectation~ extension, derived
+				from the tax key when not provided. Credit and debit notes must
+				reference the original document with a reason and the note type
+				from catálogos 09 and 10 respectively.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SUNAT - Comprobantes de Pago Electrónicos"),
+				URL:   "https://cpe.sunat.gob.pe/",
+			},
+			{
+				Title: i18n.NewString("SUNAT - Anexo 8: Catálogo de Códigos"),
+				URL:   "https://cpe.sunat.gob.pe/sites/default/files/inline-files/anexoVIII-117-2017.pdf",
+			},
+		},
+		TimeZone:    "America/Lima",
+		Extensions:  extensions,
+		Scenarios:   []*tax.ScenarioSet{invoiceScenarios},
+		Categories:  taxCategories,
+		Corrections: correctionDefinitions,
+	}
+}
@@ -0,0 +1,38 @@
+package pe
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*tax.Scenario{
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "01",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Tags:  []cbc.Key{tax.TagSimplified},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "03",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "07",
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeDebitNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyDocType: "08",
+			}),
+		},
+	},
+}
@@ -0,0 +1,58 @@
+package pe
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// IGV
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "IGV",
+			i18n.ES: "IGV",
+		},
+		Title: i18n.String{
+			i18n.EN: "General Sales Tax",
+			i18n.ES: "Impuesto General a las Ventas",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SUNAT - Impuesto General a las Ventas"),
+				URL:   "https://orientacion.sunat.gob.pe/igv-empresas",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.ES: "Tasa General",
+				},
+				Description: i18n.String{
+					i18n.EN: "Includes the 2% Municipal Promotion Tax (IPM).",
+					i18n.ES: "Incluye el 2% del Impuesto de Promoción Municipal (IPM).",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2011, 3, 1),
+						Percent: num.MakePercentage(180, 3),
+					},
+					{
+						Since:   cal.NewDate(2003, 8, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,54 @@
+package pe
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxComboRules() *rules.Set {
+	return rules.For(new(tax.Combo),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				is.Expr(`string(Category) == "VAT"`),
+				rules.Field("ext",
+					rules.Assert("01",
+						fmt.Sprintf("IGV requires '%s' extension", ExtKeyIGVAffectation),
+						tax.ExtensionsRequire(ExtKeyIGVAffectation),
+					),
+					rules.Assert("02",
+						fmt.Sprintf("IGV '%s' extension must be a valid affectation type", ExtKeyIGVAffectation),
+						tax.ExtensionHasValidCode(ExtKeyIGVAffectation),
+					),
+				),
+			),
+		),
+	)
+}
+
+// normalizeTaxCombo sets the IGV affectation type from the combo key,
+// unless already provided. Combos without a key are standard, as the key
+// is only assigned later during calculation.
+func normalizeTaxCombo(tc *tax.Combo) {
+	if tc == nil || tc.Category != tax.CategoryVAT || tc.Ext.Has(ExtKeyIGVAffectation) {
+		return
+	}
+	var code cbc.Code
+	switch tc.Key {
+	case cbc.KeyEmpty, tax.KeyStandard:
+		code = IGVAffectationTaxed
+	case tax.KeyExempt:
+		code = IGVAffectationExempt
+	case tax.KeyOutsideScope:
+		code = IGVAffectationUnaffected
+	case tax.KeyExport:
+		code = IGVAffectationExport
+	default:
+		return
+	}
+	tc.Ext = tc.Ext.Set(ExtKeyIGVAffectation, code)
+}
@@ -0,0 +1,45 @@
+package pe
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// RUC codes start with a prefix identifying the type of taxpayer: 10 for
+// individuals, 15, 16 and 17 for special cases, and 20 for companies.
+var rucRegexp = regexp.MustCompile(`^(10|15|16|17|20)\d{9}$`)
+
+var rucWeights = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Peruvian tax identity code",
+					is.Func("valid RUC", isValidRUC),
+				),
+			),
+		),
+	)
+}
+
+func isValidRUC(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !rucRegexp.MatchString(val) {
+		return false
+	}
+	sum := 0
+	for i, w := range rucWeights {
+		sum += int(val[i]-'0') * w
+	}
+	check := (11 - sum%11) % 10
+	return int(val[10]-'0') == check
+}
@@ -1,8 +1,12 @@
 package pt
 
 import (
+	"encoding/json"
+
 	"github.com/invopop/gobl/bill"
 	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/migrate"
+	"github.com/invopop/gobl/schema"
 	"github.com/invopop/gobl/tax"
 )
 
@@ -79,11 +83,121 @@ func migrateInvoiceTaxCombo(tc *tax.Combo) {
 	}
 	// 2024-09-13: Added after move to addons
 	if tc.Ext.Get(oldExtKeyExemptionCode) != "" {
-		tc.Ext = tc.Ext.Set("pt-saft-exemption", tc.Ext.Get(oldExtKeyExemptionCode))
+		tc.Ext = tc.Ext.Set(extKeySAFTExemption, tc.Ext.Get(oldExtKeyExemptionCode))
 		tc.Ext = tc.Ext.Delete(oldExtKeyExemptionCode)
 	}
 }
 
+const extKeySAFTExemption cbc.Key = "pt-saft-exemption"
+
+// migrationSteps upgrades stored documents to the format produced by
+// migrateInvoiceRates, so they may be loaded without relying on normalization.
+// Extensions already present in the stored tax combos are kept.
+func migrationSteps() []*migrate.Step {
+	return []*migrate.Step{
+		{
+			Schema:  schema.GOBL.Add(bill.ShortSchemaInvoice),
+			Version: "v0.200.0",
+			Desc:    "Portuguese exemption code extensions replaced by the SAF-T exemption extension",
+			Func:    invoiceTaxCombosMigration(migrateExemptionCodeJSON),
+		},
+		{
+			Schema:  schema.GOBL.Add(bill.ShortSchemaInvoice),
+			Version: "v0.300.0",
+			Desc:    "Portuguese exempt tax rate keys replaced by the exempt key and SAF-T exemption extension",
+			Func:    invoiceTaxCombosMigration(migrateExemptRateJSON),
+		},
+	}
+}
+
+// invoiceTaxCombosMigration prepares a migration function that applies fn
+// to each of the tax combos of a Portuguese invoice.
+func invoiceTaxCombosMigration(fn func(tc map[string]any) (bool, error)) migrate.Func {
+	return func(doc map[string]any) (bool, error) {
+		if !isPortugueseInvoice(doc) {
+			return false, nil
+		}
+		changed := false
+		for _, k := range []string{"lines", "discounts", "charges"} {
+			rows, _ := doc[k].([]any)
+			for _, row := range rows {
+				rm, _ := row.(map[string]any)
+				taxes, _ := rm["taxes"].([]any)
+				for _, t := range taxes {
+					tc, ok := t.(map[string]any)
+					if !ok {
+						continue
+					}
+					c, err := fn(tc)
+					if err != nil {
+						return false, err
+					}
+					changed = changed || c
+				}
+			}
+		}
+		return changed, nil
+	}
+}
+
+// isPortugueseInvoice checks the regime or, for documents created before the
+// regime was defined explicitly, the supplier's tax ID.
+func isPortugueseInvoice(doc map[string]any) bool {
+	if r, ok := doc["$regime"].(string); ok {
+		return r == CountryCode
+	}
+	sup, _ := doc["supplier"].(map[string]any)
+	tID, _ := sup["tax_id"].(map[string]any)
+	return tID["country"] == CountryCode
+}
+
+func migrateExemptionCodeJSON(tc map[string]any) (bool, error) {
+	ext, ok := tc["ext"].(map[string]any)
+	if !ok {
+		return false, nil
+	}
+	code, ok := ext[oldExtKeyExemptionCode.String()]
+	if !ok {
+		return false, nil
+	}
+	ext[extKeySAFTExemption.String()] = code
+	delete(ext, oldExtKeyExemptionCode.String())
+	return true, nil
+}
+
+func migrateExemptRateJSON(tc map[string]any) (bool, error) {
+	rate, _ := tc["rate"].(string)
+	key, _ := tc["key"].(string)
+	if !cbc.Key(rate).HasPrefix(TaxRateExempt) || cbc.Key(key) == TaxRateExempt {
+		return false, nil
+	}
+	for _, m := range taxRateVATExemptMigrationMap {
+		if m.Rate != cbc.Key(rate) {
+			continue
+		}
+		data, err := json.Marshal(m.Ext)
+		if err != nil {
+			return false, err
+		}
+		ext := make(map[string]any)
+		if err := json.Unmarshal(data, &ext); err != nil {
+			return false, err
+		}
+		if prev, ok := tc["ext"].(map[string]any); ok {
+			for k, v := range ext {
+				prev[k] = v
+			}
+			ext = prev
+		}
+		tc["key"] = tax.KeyExempt.String()
+		tc["ext"] = ext
+		delete(tc, "rate")
+		// The migration map still uses the old exemption code extension.
+		return migrateExemptionCodeJSON(tc)
+	}
+	return false, nil
+}
+
 var taxRateVATExemptMigrationMap = []struct {
 	Rate cbc.Key
 	Ext  tax.Extensions
@@ -1,9 +1,12 @@
 package pt_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/invopop/gobl/bill"
 	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/migrate"
 	"github.com/invopop/gobl/tax"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,3 +38,53 @@ func TestTaxRateMigration(t *testing.T) {
 	assert.Equal(t, tax.KeyExempt, t0.Key)
 	assert.Equal(t, cbc.Code("M02"), t0.Ext.Get(extKeyExemption))
 }
+
+func TestInvoiceMigrationSteps(t *testing.T) {
+	data := []byte(`{
+		"$schema": "https://gobl.org/draft-0/bill/invoice",
+		"supplier": {"name": "Test Supplier", "tax_id": {"country": "PT", "code": "123456789"}},
+		"lines": [
+			{"i": 1, "quantity": "1", "item": {"name": "Test", "price": "100"}, "taxes": [{"cat": "VAT", "rate": "exempt+outlay", "ext": {"untdid-tax-category": "E"}}]},
+			{"i": 2, "quantity": "1", "item": {"name": "Test", "price": "100"}, "taxes": [{"cat": "VAT", "rate": "exempt", "ext": {"pt-exemption-code": "M02"}}]}
+		]
+	}`)
+	out, reports, err := migrate.Upgrade(data, "v0.115.0")
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, "v0.200.0", reports[0].Version)
+	assert.True(t, reports[0].Changed)
+	assert.Equal(t, "v0.300.0", reports[1].Version)
+	assert.True(t, reports[1].Changed)
+
+	inv := new(bill.Invoice)
+	require.NoError(t, json.Unmarshal(out, inv))
+	t0 := inv.Lines[0].Taxes[0]
+	assert.Equal(t, tax.KeyExempt, t0.Key)
+	assert.Empty(t, t0.Rate)
+	assert.Equal(t, cbc.Code("M01"), t0.Ext.Get(extKeyExemption))
+	assert.Equal(t, cbc.Code("E"), t0.Ext.Get("untdid-tax-category"), "existing extensions kept")
+	assert.Equal(t, cbc.Code("M02"), inv.Lines[1].Taxes[0].Ext.Get(extKeyExemption))
+
+	// Other regimes are not affected
+	data = []byte(`{
+		"$schema": "https://gobl.org/draft-0/bill/invoice",
+		"$regime": "ES",
+		"lines": [{"taxes": [{"cat": "VAT", "rate": "exempt+outlay"}]}]
+	}`)
+	out2, reports, err := migrate.Upgrade(data, "v0.115.0")
+	require.NoError(t, err)
+	assert.False(t, reports[0].Changed)
+	assert.False(t, reports[1].Changed)
+	assert.Equal(t, data, out2)
+
+	// Only the steps after the document's version are applied
+	_, reports, err = migrate.Upgrade(out, "v0.200.0")
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.False(t, reports[0].Changed)
+
+	// Recent documents are untouched
+	_, reports, err = migrate.Upgrade(out, "v0.300.0")
+	require.NoError(t, err)
+	assert.Empty(t, reports)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/currency"
 	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/migrate"
 	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/pkg/here"
 	"github.com/invopop/gobl/rules"
@@ -23,6 +24,7 @@ func init() {
 		taxComboRules(),
 		taxIdentityRules(),
 	)
+	rules.RegisterTranslations(faultTranslations)
 	norm.Register(
 		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
 	)
@@ -30,6 +32,7 @@ func init() {
 		norm.For(migrateInvoiceRates), // *bill.Invoice
 		norm.For(normalizeTaxCombo),   // *tax.Combo
 	)
+	migrate.Register(migrationSteps()...)
 }
 
 // Custom keys used typically in meta information
@@ -0,0 +1,53 @@
+package pt
+
+import (
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+)
+
+// faultTranslations provides Portuguese versions of the fault messages
+// defined by the regime's rules.
+var faultTranslations = rules.Translations{
+	"GOBL-PT-BILL-INVOICE-01": {
+		i18n.PT: "o tipo de fatura não é válido em Portugal",
+	},
+	"GOBL-PT-BILL-INVOICE-02": {
+		i18n.PT: "o documento de origem é obrigatório para notas de crédito e de débito",
+	},
+	"GOBL-PT-BILL-INVOICE-03": {
+		i18n.PT: "o NIF do fornecedor é obrigatório",
+	},
+	"GOBL-PT-BILL-INVOICE-04": {
+		i18n.PT: "o código do NIF do fornecedor é obrigatório",
+	},
+	"GOBL-PT-BILL-INVOICE-05": {
+		i18n.PT: "a quantidade da linha deve ser zero ou positiva",
+	},
+	"GOBL-PT-BILL-INVOICE-06": {
+		i18n.PT: "o preço do artigo deve ser zero ou positivo",
+	},
+	"GOBL-PT-BILL-INVOICE-07": {
+		i18n.PT: "o montante em dívida deve ser zero ou positivo",
+	},
+	"GOBL-PT-BILL-INVOICE-11": {
+		i18n.PT: "a data valor não pode ser posterior à data de emissão",
+	},
+	"GOBL-PT-BILL-INVOICE-12": {
+		i18n.PT: "a data da operação não pode ser posterior à data de emissão",
+	},
+	"GOBL-PT-BILL-INVOICE-13": {
+		i18n.PT: "as datas de emissão dos documentos de origem não podem ser posteriores à data de emissão da fatura",
+	},
+	"GOBL-PT-BILL-INVOICE-14": {
+		i18n.PT: "as datas dos adiantamentos não podem ser posteriores à data de emissão da fatura",
+	},
+	"GOBL-PT-BILL-INVOICE-15": {
+		i18n.PT: "as datas de vencimento não podem ser anteriores à data de emissão da fatura",
+	},
+	"GOBL-PT-TAX-COMBO-01": {
+		i18n.PT: "a extensão pt-region é obrigatória para o IVA",
+	},
+	"GOBL-PT-TAX-IDENTITY-01": {
+		i18n.PT: "NIF português inválido",
+	},
+}
@@ -8,28 +8,43 @@ import (
 	_ "github.com/invopop/gobl/regimes/ae"
 	_ "github.com/invopop/gobl/regimes/ar"
 	_ "github.com/invopop/gobl/regimes/at"
+	_ "github.com/invopop/gobl/regimes/au"
 	_ "github.com/invopop/gobl/regimes/be"
 	_ "github.com/invopop/gobl/regimes/br"
 	_ "github.com/invopop/gobl/regimes/ca"
 	_ "github.com/invopop/gobl/regimes/ch"
+	_ "github.com/invopop/gobl/regimes/cl"
 	_ "github.com/invopop/gobl/regimes/co"
+	_ "github.com/invopop/gobl/regimes/cz"
 	_ "github.com/invopop/gobl/regimes/de"
 	_ "github.com/invopop/gobl/regimes/dk"
+	_ "github.com/invopop/gobl/regimes/eg"
 	_ "github.com/invopop/gobl/regimes/es"
 	_ "github.com/invopop/gobl/regimes/fi"
 	_ "github.com/invopop/gobl/regimes/fr"
 	_ "github.com/invopop/gobl/regimes/gb"
 	_ "github.com/invopop/gobl/regimes/gr"
+	_ "github.com/invopop/gobl/regimes/hr"
+	_ "github.com/invopop/gobl/regimes/hu"
 	_ "github.com/invopop/gobl/regimes/ie"
 	_ "github.com/invopop/gobl/regimes/in"
 	_ "github.com/invopop/gobl/regimes/it"
+	_ "github.com/invopop/gobl/regimes/jp"
+	_ "github.com/invopop/gobl/regimes/ke"
 	_ "github.com/invopop/gobl/regimes/mx"
+	_ "github.com/invopop/gobl/regimes/my"
 	_ "github.com/invopop/gobl/regimes/nl"
 	_ "github.com/invopop/gobl/regimes/no"
+	_ "github.com/invopop/gobl/regimes/nz"
+	_ "github.com/invopop/gobl/regimes/pe"
 	_ "github.com/invopop/gobl/regimes/pl"
 	_ "github.com/invopop/gobl/regimes/pt"
+	_ "github.com/invopop/gobl/regimes/ro"
 	_ "github.com/invopop/gobl/regimes/sa"
 	_ "github.com/invopop/gobl/regimes/se"
 	_ "github.com/invopop/gobl/regimes/sg"
+	_ "github.com/invopop/gobl/regimes/sk"
+	_ "github.com/invopop/gobl/regimes/tr"
 	_ "github.com/invopop/gobl/regimes/us"
+	_ "github.com/invopop/gobl/regimes/za"
 )
@@ -0,0 +1,40 @@
+package ro
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// Invoice rules cover the tax document requirements of art. 319 of the Fiscal
+// Code, where both parties must be identified by their VAT code when the
+// customer accounts for the tax.
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+				),
+			),
+			rules.When(is.Func("reverse charge", isReverseChargeInvoice),
+				rules.Field("customer",
+					rules.Assert("02", "invoice customer is required for reverse charge", is.Present),
+					rules.Field("tax_id",
+						rules.Assert("03", "invoice customer tax ID is required for reverse charge", is.Present),
+						rules.Field("code",
+							rules.Assert("04", "invoice customer tax ID code is required for reverse charge", is.Present),
+						),
+					),
+				),
+			),
+		),
+	)
+}
+
+func isReverseChargeInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && inv.HasTags(tax.TagReverseCharge)
+}
@@ -0,0 +1,157 @@
+package ro_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/ro"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(ro.CountryCode),
+		Code:      "RO-2025-001",
+		IssueDate: cal.MakeDate(2025, 9, 1),
+		Supplier: &org.Party{
+			Name: "Furnizor S.R.L.",
+			TaxID: &tax.Identity{
+				Country: "RO",
+				Code:    "18547290",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Client S.A.",
+			TaxID: &tax.Identity{
+				Country: "RO",
+				Code:    "14399840",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Lucrări de construcții",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2025, 9, 1),
+			rate: tax.RateGeneral,
+			tax:  "210.00",
+		},
+		{
+			name: "general before August 2025",
+			date: cal.MakeDate(2025, 7, 31),
+			rate: tax.RateGeneral,
+			tax:  "190.00",
+		},
+		{
+			name: "second reduced rate merged",
+			date: cal.MakeDate(2025, 9, 1),
+			rate: tax.RateSuperReduced,
+			err:  "rate value unavailable",
+		},
+		{
+			name: "second reduced rate before August 2025",
+			date: cal.MakeDate(2025, 7, 31),
+			rate: tax.RateSuperReduced,
+			tax:  "50.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "RON", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-BILL-INVOICE-01]")
+	})
+	t.Run("reverse charge without customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(tax.TagReverseCharge)
+		inv.Customer.TaxID = nil
+		inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-RO-BILL-INVOICE-03]")
+	})
+}
+
+func TestInvoiceReverseChargeScenarios(t *testing.T) {
+	tests := []struct {
+		name    string
+		country l10n.TaxCountryCode
+		code    cbc.Code
+		text    string
+	}{
+		{
+			name:    "domestic",
+			country: "RO",
+			code:    "14399840",
+			text:    "Taxare inversă conform art. 331 din Codul fiscal.",
+		},
+		{
+			name:    "intra-community",
+			country: "DE",
+			code:    "111111125",
+			text:    "Reverse charge / Taxare inversă.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.SetTags(tax.TagReverseCharge)
+			inv.Customer.TaxID = &tax.Identity{Country: tt.country, Code: tt.code}
+			inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			require.Len(t, inv.Tax.Notes, 1)
+			assert.Equal(t, tt.text, inv.Tax.Notes[0].Text)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package ro provides the tax regime definition for Romania.
+package ro
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Romania.
+const CountryCode = "RO"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("ro", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.RON,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Romania",
+			i18n.RO: "România",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Romania's VAT (Taxa pe valoarea adăugată, TVA) is administered by the
+				National Agency for Fiscal Administration (ANAF) under Title VII of
+				the Fiscal Code (Law 227/2015). From August 2025 the general rate
+				increased to 21% and a single reduced rate of 11% replaced the
+				previous 9% and 5% rates.
+
+				Businesses are identified by their fiscal identification code (CIF
+				or CUI) of 2 to 10 digits, the last of which is a check digit. VAT
+				registered businesses use the same code with the RO prefix.
+
+				Domestic reverse charge (taxare inversă) under art. 331 applies to
+				supplies between VAT registered businesses of goods such as waste,
+				cereals, wood, and construction work, and the invoice must include
+				the mention "taxare inversă".
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Codul fiscal - Titlul VII Taxa pe valoarea adăugată"),
+				URL:   "https://static.anaf.ro/static/10/Anaf/legislatie/Cod_fiscal_norme_2023.htm",
+			},
+		},
+		TimeZone: "Europe/Bucharest",
+		Scenarios: []*tax.ScenarioSet{
+			invoiceScenarios,
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
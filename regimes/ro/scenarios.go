@@ -0,0 +1,15 @@
+package ro
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: bill.ReverseChargeScenarios(
+		CountryCode,
+		"Reverse charge / Taxare inversă.",
+		"Taxare inversă conform art. 331 din Codul fiscal.",
+	),
+}
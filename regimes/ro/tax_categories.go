@@ -0,0 +1,105 @@
+package ro
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.RO: "TVA",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.RO: "Taxa pe valoarea adăugată",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "Fiscal Code - Article 291 VAT rates",
+					i18n.RO: "Codul fiscal - Articolul 291 Cotele",
+				},
+				URL: "https://static.anaf.ro/static/10/Anaf/legislatie/Cod_fiscal_norme_2023.htm",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.RO: "Cota standard",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2025, 8, 1),
+						Percent: num.MakePercentage(210, 3),
+					},
+					{
+						Since:   cal.NewDate(2017, 1, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+					{
+						Since:   cal.NewDate(2016, 1, 1),
+						Percent: num.MakePercentage(200, 3),
+					},
+					{
+						Since:   cal.NewDate(2010, 7, 1),
+						Percent: num.MakePercentage(240, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 1, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.RO: "Cota redusă",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2025, 8, 1),
+						Percent: num.MakePercentage(110, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 1, 1),
+						Percent: num.MakePercentage(90, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateSuperReduced,
+				Name: i18n.String{
+					i18n.EN: "Second Reduced Rate",
+					i18n.RO: "A doua cotă redusă",
+				},
+				Description: i18n.String{
+					i18n.EN: "Merged into the single reduced rate from August 2025.",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2008, 12, 1),
+						Until:   cal.NewDate(2025, 7, 31),
+						Percent: num.MakePercentage(50, 3),
+					},
+				},
+			},
+		},
+	},
+}
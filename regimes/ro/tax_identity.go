@@ -0,0 +1,52 @@
+package ro
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// CIF/CUI codes have between 2 and 10 digits, the last being the check
+// digit calculated with the "753217532" key.
+var (
+	taxCodeRegexp = regexp.MustCompile(`^[1-9]\d{1,9}$`)
+	taxCodeKey    = []int{7, 5, 3, 2, 1, 7, 5, 3, 2}
+)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Romanian tax identity code",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+			),
+		),
+	)
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !taxCodeRegexp.MatchString(val) {
+		return false
+	}
+	l := len(val) - 1
+	base := strings.Repeat("0", 9-l) + val[:l]
+	sum := 0
+	for i, k := range taxCodeKey {
+		sum += int(base[i]-'0') * k
+	}
+	check := sum * 10 % 11
+	if check == 10 {
+		check = 0
+	}
+	return check == int(val[l]-'0')
+}
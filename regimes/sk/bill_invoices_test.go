@@ -0,0 +1,145 @@
+package sk_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/sk"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(sk.CountryCode),
+		Code:      "2025001",
+		IssueDate: cal.MakeDate(2025, 3, 1),
+		Supplier: &org.Party{
+			Name: "Dodávateľ s.r.o.",
+			TaxID: &tax.Identity{
+				Country: "SK",
+				Code:    "2022749619",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Odberateľ a.s.",
+			TaxID: &tax.Identity{
+				Country: "SK",
+				Code:    "2020372640",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Stavebné práce",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2025, 3, 1),
+			rate: tax.RateGeneral,
+			tax:  "230.00",
+		},
+		{
+			name: "general before 2025",
+			date: cal.MakeDate(2024, 12, 31),
+			rate: tax.RateGeneral,
+			tax:  "200.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "EUR", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-SK-BILL-INVOICE-01]")
+	})
+	t.Run("reverse charge without customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(tax.TagReverseCharge)
+		inv.Customer.TaxID = nil
+		inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-SK-BILL-INVOICE-03]")
+	})
+}
+
+func TestInvoiceReverseChargeScenarios(t *testing.T) {
+	tests := []struct {
+		name    string
+		country l10n.TaxCountryCode
+		code    cbc.Code
+		text    string
+	}{
+		{
+			name:    "domestic",
+			country: "SK",
+			code:    "2020372640",
+			text:    "Prenesenie daňovej povinnosti podľa § 69 ods. 12 zákona o DPH.",
+		},
+		{
+			name:    "intra-community",
+			country: "DE",
+			code:    "111111125",
+			text:    "Reverse charge / Prenesenie daňovej povinnosti.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.SetTags(tax.TagReverseCharge)
+			inv.Customer.TaxID = &tax.Identity{Country: tt.country, Code: tt.code}
+			inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyReverseCharge}
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			require.Len(t, inv.Tax.Notes, 1)
+			assert.Equal(t, tt.text, inv.Tax.Notes[0].Text)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package sk
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/tax"
+)
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: bill.ReverseChargeScenarios(
+		CountryCode,
+		"Reverse charge / Prenesenie daňovej povinnosti.",
+		"Prenesenie daňovej povinnosti podľa § 69 ods. 12 zákona o DPH.",
+	),
+}
@@ -0,0 +1,75 @@
+// Package sk provides the tax regime definition for Slovakia.
+package sk
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Slovakia.
+const CountryCode = "SK"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("sk", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.EUR,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Slovakia",
+			i18n.SK: "Slovensko",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Slovakia's VAT (Daň z pridanej hodnoty, DPH) is administered by the
+				Financial Administration (Finančná správa) under Act No. 222/2004 Coll.
+				From 2025 the general rate increased to 23% and two reduced rates of
+				19% and 5% apply.
+
+				VAT payers are identified by their IČ DPH, the SK prefix followed by
+				10 digits that must be divisible by 11.
+
+				Domestic reverse charge (prenesenie daňovej povinnosti) under § 69
+				ods. 12 applies to supplies such as construction work, scrap metal,
+				and mobile phones over a threshold, and the invoice must include the
+				words "prenesenie daňovej povinnosti".
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("Zákon o dani z pridanej hodnoty (222/2004 Z. z.)"),
+				URL:   "https://www.slov-lex.sk/pravne-predpisy/SK/ZZ/2004/222/",
+			},
+		},
+		TimeZone: "Europe/Bratislava",
+		Scenarios: []*tax.ScenarioSet{
+			invoiceScenarios,
+		},
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package sk
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.SK: "DPH",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.SK: "Daň z pridanej hodnoty",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.String{
+					i18n.EN: "Financial Administration - VAT rates",
+					i18n.SK: "Finančná správa - Sadzby DPH",
+				},
+				URL: "https://www.financnasprava.sk/sk/podnikatelia/dane/dan-z-pridanej-hodnoty",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.SK: "Základná sadzba",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2025, 1, 1),
+						Percent: num.MakePercentage(230, 3),
+					},
+					{
+						Since:   cal.NewDate(2011, 1, 1),
+						Percent: num.MakePercentage(200, 3),
+					},
+					{
+						Since:   cal.NewDate(2004, 1, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateIntermediate,
+				Name: i18n.String{
+					i18n.EN: "First Reduced Rate",
+					i18n.SK: "Prvá znížená sadzba",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2025, 1, 1),
+						Percent: num.MakePercentage(190, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.SK: "Znížená sadzba",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2025, 1, 1),
+						Percent: num.MakePercentage(50, 3),
+					},
+					{
+						Since:   cal.NewDate(2007, 5, 1),
+						Percent: num.MakePercentage(100, 3),
+					},
+				},
+			},
+		},
+	},
+}
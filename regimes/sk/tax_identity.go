@@ -0,0 +1,43 @@
+package sk
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// IČ DPH codes are 10 digits, the first not zero and the third one of
+// 2, 3, 4, 7, 8, or 9.
+var taxCodeRegexp = regexp.MustCompile(`^[1-9]\d[234789]\d{7}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Slovak tax identity code",
+					is.Func("valid", isValidTaxIdentityCode),
+				),
+			),
+		),
+	)
+}
+
+func isValidTaxIdentityCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	if !taxCodeRegexp.MatchString(val) {
+		return false
+	}
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false
+	}
+	return n%11 == 0
+}
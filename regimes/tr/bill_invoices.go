@@ -0,0 +1,75 @@
+package tr
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+					rules.Field("code",
+						rules.Assert("02", "invoice supplier tax ID code is required", is.Present),
+					),
+				),
+			),
+			// e-Fatura is only exchanged between registered taxpayers.
+			rules.When(is.Func("e-Fatura", isEFatura),
+				rules.Field("customer",
+					rules.Assert("03", "invoice customer is required for e-Fatura", is.Present),
+					rules.Field("tax_id",
+						rules.Assert("04", "invoice customer tax ID is required for e-Fatura", is.Present),
+						rules.Field("code",
+							rules.Assert("05", "invoice customer tax ID code is required for e-Fatura", is.Present),
+						),
+					),
+				),
+			),
+			rules.Field("tax",
+				rules.Assert("06", "invoice tax is required", is.Present),
+				rules.Field("ext",
+					rules.Assert("07",
+						fmt.Sprintf("invoice tax requires '%s' extension", ExtKeyProfile),
+						tax.ExtensionsRequire(ExtKeyProfile),
+					),
+					rules.Assert("08",
+						fmt.Sprintf("invoice tax '%s' extension must be a valid profile", ExtKeyProfile),
+						tax.ExtensionHasValidCode(ExtKeyProfile),
+					),
+					rules.Assert("09",
+						fmt.Sprintf("invoice tax requires '%s' extension", ExtKeyInvoiceType),
+						tax.ExtensionsRequire(ExtKeyInvoiceType),
+					),
+					rules.Assert("10",
+						fmt.Sprintf("invoice tax '%s' extension must be a valid invoice type", ExtKeyInvoiceType),
+						tax.ExtensionHasValidCode(ExtKeyInvoiceType),
+					),
+				),
+			),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote),
+				rules.Field("preceding",
+					rules.Assert("11", "preceding documents are required for returns", is.Present),
+				),
+			),
+		),
+	)
+}
+
+// isEFatura is true when the invoice is exchanged through the e-Fatura
+// system rather than issued as an e-Arşiv invoice.
+func isEFatura(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	if !ok || inv == nil || inv.Tax == nil {
+		return false
+	}
+	return inv.Tax.Ext.Get(ExtKeyProfile).In(ProfileBasic, ProfileCommercial)
+}
@@ -0,0 +1,234 @@
+package tr_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/tr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(tr.CountryCode),
+		Series:    "ABC",
+		Code:      "2024000000001",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Tedarikçi A.Ş.",
+			TaxID: &tax.Identity{
+				Country: "TR",
+				Code:    "4700099607",
+			},
+		},
+		Customer: &org.Party{
+			Name: "Müşteri Ltd. Şti.",
+			TaxID: &tax.Identity{
+				Country: "TR",
+				Code:    "1234567890",
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Danışmanlık hizmeti",
+					Price: num.NewAmount(10000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2024, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "200.00",
+		},
+		{
+			name: "general before July 2023",
+			date: cal.MakeDate(2023, 7, 9),
+			rate: tax.RateGeneral,
+			tax:  "180.00",
+		},
+		{
+			name: "reduced before July 2023",
+			date: cal.MakeDate(2023, 1, 1),
+			rate: tax.RateReduced,
+			tax:  "80.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestInvoiceScenarios(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(inv *bill.Invoice)
+		profile cbc.Code
+		typ     cbc.Code
+	}{
+		{
+			name:    "basic e-Fatura",
+			setup:   func(_ *bill.Invoice) {},
+			profile: tr.ProfileBasic,
+			typ:     tr.InvoiceTypeSale,
+		},
+		{
+			name: "commercial e-Fatura",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tr.TagCommercial)
+			},
+			profile: tr.ProfileCommercial,
+			typ:     tr.InvoiceTypeSale,
+		},
+		{
+			name: "e-Arşiv",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tr.TagEArchive)
+				inv.Customer.TaxID = nil
+			},
+			profile: tr.ProfileEArchive,
+			typ:     tr.InvoiceTypeSale,
+		},
+		{
+			name: "simplified e-Arşiv",
+			setup: func(inv *bill.Invoice) {
+				inv.SetTags(tax.TagSimplified)
+				inv.Customer = nil
+			},
+			profile: tr.ProfileEArchive,
+			typ:     tr.InvoiceTypeSale,
+		},
+		{
+			name: "exempt",
+			setup: func(inv *bill.Invoice) {
+				inv.Lines[0].Taxes[0] = &tax.Combo{Category: tax.CategoryVAT, Key: tax.KeyExempt}
+			},
+			profile: tr.ProfileBasic,
+			typ:     tr.InvoiceTypeExemption,
+		},
+		{
+			name: "withholding",
+			setup: func(inv *bill.Invoice) {
+				inv.Lines[0].Taxes = append(inv.Lines[0].Taxes, &tax.Combo{
+					Category: tr.TaxCategoryKDVT,
+					Percent:  num.NewPercentage(100, 3),
+					Ext: tax.ExtensionsOf(cbc.CodeMap{
+						tr.ExtKeyTevkifat: "604",
+					}),
+				})
+			},
+			profile: tr.ProfileBasic,
+			typ:     tr.InvoiceTypeWithholding,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			tt.setup(inv)
+			require.NoError(t, inv.Calculate())
+			require.NoError(t, rules.Validate(inv))
+			assert.Equal(t, tt.profile, inv.Tax.Ext.Get(tr.ExtKeyProfile))
+			assert.Equal(t, tt.typ, inv.Tax.Ext.Get(tr.ExtKeyInvoiceType))
+		})
+	}
+}
+
+func TestInvoiceWithholding(t *testing.T) {
+	inv := validInvoice()
+	inv.Lines[0].Taxes = append(inv.Lines[0].Taxes, &tax.Combo{
+		Category: tr.TaxCategoryKDVT,
+		Percent:  num.NewPercentage(100, 3),
+		Ext: tax.ExtensionsOf(cbc.CodeMap{
+			tr.ExtKeyTevkifat: "604",
+		}),
+	})
+	require.NoError(t, inv.Calculate())
+	require.NoError(t, rules.Validate(inv))
+	assert.Equal(t, "200.00", inv.Totals.Tax.String())
+	assert.Equal(t, "100.00", inv.Totals.RetainedTax.String())
+	assert.Equal(t, "1100.00", inv.Totals.Payable.String())
+
+	t.Run("missing code", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes = append(inv.Lines[0].Taxes, &tax.Combo{
+			Category: tr.TaxCategoryKDVT,
+			Percent:  num.NewPercentage(100, 3),
+		})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-TR-TAX-COMBO-02]")
+	})
+	t.Run("invalid code", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Lines[0].Taxes = append(inv.Lines[0].Taxes, &tax.Combo{
+			Category: tr.TaxCategoryKDVT,
+			Percent:  num.NewPercentage(100, 3),
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				tr.ExtKeyTevkifat: "699",
+			}),
+		})
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-TR-TAX-COMBO-03]")
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "TRY", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-TR-BILL-INVOICE-01]")
+	})
+	t.Run("e-Fatura missing customer tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-TR-BILL-INVOICE-04]")
+	})
+	t.Run("return without preceding", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Type = bill.InvoiceTypeCreditNote
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, tr.InvoiceTypeReturn, inv.Tax.Ext.Get(tr.ExtKeyInvoiceType))
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-TR-BILL-INVOICE-11]")
+	})
+}
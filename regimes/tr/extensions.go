@@ -0,0 +1,416 @@
+package tr
+
+import (
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/pkg/here"
+)
+
+// Regime extension keys
+const (
+	ExtKeyProfile     cbc.Key = "tr-profile"
+	ExtKeyInvoiceType cbc.Key = "tr-invoice-type"
+	ExtKeyTevkifat    cbc.Key = "tr-tevkifat-code"
+)
+
+// Profile codes
+const (
+	ProfileBasic      cbc.Code = "TEMELFATURA"
+	ProfileCommercial cbc.Code = "TICARIFATURA"
+	ProfileEArchive   cbc.Code = "EARSIVFATURA"
+)
+
+// Invoice type codes
+const (
+	InvoiceTypeSale        cbc.Code = "SATIS"
+	InvoiceTypeReturn      cbc.Code = "IADE"
+	InvoiceTypeWithholding cbc.Code = "TEVKIFAT"
+	InvoiceTypeExemption   cbc.Code = "ISTISNA"
+)
+
+// MetaKeyRatio is used in the withholding code definitions to provide the
+// share of the KDV withheld by the customer.
+const MetaKeyRatio cbc.Key = "ratio"
+
+var extensions = []*cbc.Definition{
+	{
+		Key: ExtKeyProfile,
+		Name: i18n.String{
+			i18n.EN: "Profile",
+			i18n.TR: "Senaryo",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Invoice profile (ProfileID) determining how the document is
+				exchanged, set automatically from the invoice tags.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "TEMELFATURA",
+				Name: i18n.String{
+					i18n.EN: "Basic invoice",
+					i18n.TR: "Temel fatura",
+				},
+			},
+			{
+				Code: "TICARIFATURA",
+				Name: i18n.String{
+					i18n.EN: "Commercial invoice",
+					i18n.TR: "Ticari fatura",
+				},
+			},
+			{
+				Code: "EARSIVFATURA",
+				Name: i18n.String{
+					i18n.EN: "e-Archive invoice",
+					i18n.TR: "e-Arşiv fatura",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyInvoiceType,
+		Name: i18n.String{
+			i18n.EN: "Invoice Type",
+			i18n.TR: "Fatura Tipi",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Invoice type code (InvoiceTypeCode), set automatically from the
+				invoice type and tax categories: ~TEVKIFAT~ is used when KDV is
+				withheld, and ~ISTISNA~ when all lines are exempt.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "SATIS",
+				Name: i18n.String{
+					i18n.EN: "Sale",
+					i18n.TR: "Satış",
+				},
+			},
+			{
+				Code: "IADE",
+				Name: i18n.String{
+					i18n.EN: "Return",
+					i18n.TR: "İade",
+				},
+			},
+			{
+				Code: "TEVKIFAT",
+				Name: i18n.String{
+					i18n.EN: "Withholding",
+					i18n.TR: "Tevkifat",
+				},
+			},
+			{
+				Code: "ISTISNA",
+				Name: i18n.String{
+					i18n.EN: "Exemption",
+					i18n.TR: "İstisna",
+				},
+			},
+			{
+				Code: "OZELMATRAH",
+				Name: i18n.String{
+					i18n.EN: "Special tax base",
+					i18n.TR: "Özel matrah",
+				},
+			},
+			{
+				Code: "IHRACKAYITLI",
+				Name: i18n.String{
+					i18n.EN: "Export registered",
+					i18n.TR: "İhraç kayıtlı",
+				},
+			},
+		},
+	},
+	{
+		Key: ExtKeyTevkifat,
+		Name: i18n.String{
+			i18n.EN: "Withholding Code",
+			i18n.TR: "Tevkifat Kodu",
+		},
+		Desc: i18n.String{
+			i18n.EN: here.Doc(`
+				Official KDV withholding code for partial withholding, required
+				on ~KDVT~ taxes. The ratio of the KDV withheld is provided in each
+				code's ~ratio~ meta field.
+			`),
+		},
+		Values: []*cbc.Definition{
+			{
+				Code: "601",
+				Name: i18n.String{
+					i18n.EN: "Construction works and related engineering, architecture and survey-project services",
+					i18n.TR: "Yapım işleri ile bu işlerle birlikte ifa edilen mühendislik-mimarlık ve etüt-proje hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "4/10",
+				},
+			},
+			{
+				Code: "602",
+				Name: i18n.String{
+					i18n.EN: "Survey, planning, consultancy, audit and similar services",
+					i18n.TR: "Etüt, plan-proje, danışmanlık, denetim ve benzeri hizmetler",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "603",
+				Name: i18n.String{
+					i18n.EN: "Modification, maintenance and repair of machinery, equipment, fixtures and vehicles",
+					i18n.TR: "Makine, teçhizat, demirbaş ve taşıtlara ait tadil, bakım ve onarım hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "604",
+				Name: i18n.String{
+					i18n.EN: "Catering services",
+					i18n.TR: "Yemek servis hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+			{
+				Code: "605",
+				Name: i18n.String{
+					i18n.EN: "Organisation services",
+					i18n.TR: "Organizasyon hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+			{
+				Code: "606",
+				Name: i18n.String{
+					i18n.EN: "Labour supply services",
+					i18n.TR: "İşgücü temin hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "607",
+				Name: i18n.String{
+					i18n.EN: "Private security services",
+					i18n.TR: "Özel güvenlik hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "608",
+				Name: i18n.String{
+					i18n.EN: "Building inspection services",
+					i18n.TR: "Yapı denetim hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "609",
+				Name: i18n.String{
+					i18n.EN: "Contract textile, clothing, bag and shoe manufacturing",
+					i18n.TR: "Fason olarak yaptırılan tekstil ve konfeksiyon işleri, çanta ve ayakkabı dikim işleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "610",
+				Name: i18n.String{
+					i18n.EN: "Customer referral services for tourist shops",
+					i18n.TR: "Turistik mağazalara verilen müşteri bulma/götürme hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "611",
+				Name: i18n.String{
+					i18n.EN: "Broadcasting, advertising and naming rights of sports clubs",
+					i18n.TR: "Spor kulüplerinin yayın, reklam ve isim hakkı gelirlerine konu işlemleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "612",
+				Name: i18n.String{
+					i18n.EN: "Cleaning services",
+					i18n.TR: "Temizlik hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "613",
+				Name: i18n.String{
+					i18n.EN: "Environmental and garden maintenance services",
+					i18n.TR: "Çevre ve bahçe bakım hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "614",
+				Name: i18n.String{
+					i18n.EN: "Shuttle transport services",
+					i18n.TR: "Servis taşımacılığı hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+			{
+				Code: "615",
+				Name: i18n.String{
+					i18n.EN: "Printing services",
+					i18n.TR: "Her türlü baskı ve basım hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "616",
+				Name: i18n.String{
+					i18n.EN: "Other services",
+					i18n.TR: "Diğer hizmetler",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+			{
+				Code: "617",
+				Name: i18n.String{
+					i18n.EN: "Ingots obtained from scrap metal",
+					i18n.TR: "Hurda metalden elde edilen külçe teslimleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "618",
+				Name: i18n.String{
+					i18n.EN: "Copper, zinc, iron, steel, aluminium and lead ingots not obtained from scrap metal",
+					i18n.TR: "Hurda metalden elde edilenler dışındaki bakır, çinko, demir çelik, alüminyum ve kurşun külçe teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "619",
+				Name: i18n.String{
+					i18n.EN: "Copper, zinc and aluminium products",
+					i18n.TR: "Bakır, çinko ve alüminyum ürünlerinin teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "620",
+				Name: i18n.String{
+					i18n.EN: "Scrap and waste by suppliers waiving the exemption",
+					i18n.TR: "İstisnadan vazgeçenlerin hurda ve atık teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "7/10",
+				},
+			},
+			{
+				Code: "621",
+				Name: i18n.String{
+					i18n.EN: "Raw materials obtained from metal, plastic, rubber, paper and glass scrap",
+					i18n.TR: "Metal, plastik, lastik, kauçuk, kağıt ve cam hurda ve atıklardan elde edilen hammadde teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "622",
+				Name: i18n.String{
+					i18n.EN: "Cotton, mohair, wool, raw hides and skins",
+					i18n.TR: "Pamuk, tiftik, yün ve yapağı ile ham post ve deri teslimleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "9/10",
+				},
+			},
+			{
+				Code: "623",
+				Name: i18n.String{
+					i18n.EN: "Wood and forest products",
+					i18n.TR: "Ağaç ve orman ürünleri teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+			{
+				Code: "624",
+				Name: i18n.String{
+					i18n.EN: "Freight transport services",
+					i18n.TR: "Yük taşımacılığı hizmeti",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "2/10",
+				},
+			},
+			{
+				Code: "625",
+				Name: i18n.String{
+					i18n.EN: "Commercial advertising services",
+					i18n.TR: "Ticari reklam hizmetleri",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "3/10",
+				},
+			},
+			{
+				Code: "626",
+				Name: i18n.String{
+					i18n.EN: "Other deliveries",
+					i18n.TR: "Diğer teslimler",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "2/10",
+				},
+			},
+			{
+				Code: "627",
+				Name: i18n.String{
+					i18n.EN: "Iron and steel products",
+					i18n.TR: "Demir-çelik ürünlerinin teslimi",
+				},
+				Meta: cbc.Meta{
+					MetaKeyRatio: "5/10",
+				},
+			},
+		},
+	},
+}
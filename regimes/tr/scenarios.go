@@ -0,0 +1,121 @@
+package tr
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/tax"
+)
+
+// Invoice tags
+const (
+	TagCommercial cbc.Key = "commercial"
+	TagEArchive   cbc.Key = "e-archive"
+)
+
+var invoiceTags = &tax.TagSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*cbc.Definition{
+		{
+			Key: TagCommercial,
+			Name: i18n.String{
+				i18n.EN: "Commercial Invoice",
+				i18n.TR: "Ticari Fatura",
+			},
+			Desc: i18n.String{
+				i18n.EN: "e-Fatura sent with the commercial profile, which the customer may accept or reject.",
+			},
+		},
+		{
+			Key: TagEArchive,
+			Name: i18n.String{
+				i18n.EN: "e-Archive Invoice",
+				i18n.TR: "e-Arşiv Fatura",
+			},
+			Desc: i18n.String{
+				i18n.EN: "Invoice issued to a customer not registered with the e-Fatura system.",
+			},
+		},
+	},
+}
+
+var invoiceScenarios = &tax.ScenarioSet{
+	Schema: bill.ShortSchemaInvoice,
+	List: []*tax.Scenario{
+		// ** Profiles **
+		{
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyProfile: ProfileBasic,
+			}),
+		},
+		{
+			Tags: []cbc.Key{TagCommercial},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyProfile: ProfileCommercial,
+			}),
+		},
+		{
+			Tags: []cbc.Key{TagEArchive},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyProfile: ProfileEArchive,
+			}),
+		},
+		{
+			Tags: []cbc.Key{tax.TagSimplified},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyProfile: ProfileEArchive,
+			}),
+		},
+		// ** Invoice types **
+		{
+			Types: []cbc.Key{bill.InvoiceTypeStandard},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyInvoiceType: InvoiceTypeSale,
+			}),
+		},
+		{
+			Types:  []cbc.Key{bill.InvoiceTypeStandard},
+			Filter: isExemptInvoice,
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyInvoiceType: InvoiceTypeExemption,
+			}),
+		},
+		{
+			Types:      []cbc.Key{bill.InvoiceTypeStandard},
+			Categories: []cbc.Code{TaxCategoryKDVT},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyInvoiceType: InvoiceTypeWithholding,
+			}),
+		},
+		{
+			Types: []cbc.Key{bill.InvoiceTypeCreditNote},
+			Ext: tax.ExtensionsOf(cbc.CodeMap{
+				ExtKeyInvoiceType: InvoiceTypeReturn,
+			}),
+		},
+	},
+}
+
+// isExemptInvoice is true when all the KDV in the invoice lines is exempt.
+func isExemptInvoice(doc any) bool {
+	inv, ok := doc.(*bill.Invoice)
+	if !ok || len(inv.Lines) == 0 {
+		return false
+	}
+	found := false
+	for _, line := range inv.Lines {
+		if line == nil {
+			continue
+		}
+		for _, combo := range line.Taxes {
+			if combo == nil || combo.Category != tax.CategoryVAT {
+				continue
+			}
+			if combo.Key != tax.KeyExempt {
+				return false
+			}
+			found = true
+		}
+	}
+	return found
+}
@@ -0,0 +1,115 @@
+package tr
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/tax"
+)
+
+// Local tax categories.
+const (
+	TaxCategoryKDVT cbc.Code = "KDVT" // KDV Tevkifatı
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// KDV
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.TR: "KDV",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.TR: "Katma Değer Vergisi",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("GİB - Katma Değer Vergisi Kanunu"),
+				URL:   "https://www.gib.gov.tr/node/87593",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "General Rate",
+					i18n.TR: "Genel Oran",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2023, 7, 10),
+						Percent: num.MakePercentage(200, 3),
+					},
+					{
+						Since:   cal.NewDate(2001, 5, 15),
+						Percent: num.MakePercentage(180, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateReduced,
+				Name: i18n.String{
+					i18n.EN: "Reduced Rate",
+					i18n.TR: "İndirimli Oran",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2023, 7, 10),
+						Percent: num.MakePercentage(100, 3),
+					},
+					{
+						Since:   cal.NewDate(2008, 1, 1),
+						Percent: num.MakePercentage(80, 3),
+					},
+				},
+			},
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateSuperReduced,
+				Name: i18n.String{
+					i18n.EN: "Super-Reduced Rate",
+					i18n.TR: "Süper İndirimli Oran",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2008, 1, 1),
+						Percent: num.MakePercentage(10, 3),
+					},
+				},
+			},
+		},
+	},
+	//
+	// KDV Tevkifatı
+	//
+	{
+		Code: TaxCategoryKDVT,
+		Name: i18n.String{
+			i18n.EN: "VAT Withholding",
+			i18n.TR: "KDV Tevkifatı",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax Withholding",
+			i18n.TR: "Katma Değer Vergisi Tevkifatı",
+		},
+		Description: &i18n.String{
+			i18n.EN: here.Doc(`
+				Share of the KDV withheld by the customer. The percent must be
+				provided as the withholding ratio applied to the line's KDV rate,
+				for example 10% for a 5/10 ratio on the 20% general rate.
+			`),
+		},
+		Retained: true,
+		Rates:    []*tax.RateDef{},
+	},
+}
@@ -0,0 +1,33 @@
+package tr
+
+import (
+	"fmt"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+func taxComboRules() *rules.Set {
+	return rules.For(new(tax.Combo),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.When(
+				is.Expr(`string(Category) == "KDVT"`),
+				rules.Field("percent",
+					rules.Assert("01", "withholding percent is required", is.Present),
+				),
+				rules.Field("ext",
+					rules.Assert("02",
+						fmt.Sprintf("withholding requires '%s' extension", ExtKeyTevkifat),
+						tax.ExtensionsRequire(ExtKeyTevkifat),
+					),
+					rules.Assert("03",
+						fmt.Sprintf("withholding '%s' extension must be a valid code", ExtKeyTevkifat),
+						tax.ExtensionHasValidCode(ExtKeyTevkifat),
+					),
+				),
+			),
+		),
+	)
+}
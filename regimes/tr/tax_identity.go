@@ -0,0 +1,81 @@
+package tr
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+var (
+	vknRegexp  = regexp.MustCompile(`^\d{10}$`)
+	tcknRegexp = regexp.MustCompile(`^[1-9]\d{10}$`)
+)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid Turkish tax identity code",
+					is.Func("valid VKN or TCKN", isValidTaxCode),
+				),
+			),
+		),
+	)
+}
+
+// isValidTaxCode accepts either a company VKN or an individual's TCKN,
+// which may also be used as a tax number.
+func isValidTaxCode(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	val := code.String()
+	switch {
+	case vknRegexp.MatchString(val):
+		return isValidVKN(val)
+	case tcknRegexp.MatchString(val):
+		return isValidTCKN(val)
+	}
+	return false
+}
+
+// isValidVKN checks the last digit of a 10 digit VKN, calculated from
+// the first nine digits shifted by position and weighted by powers of two
+// modulo 9.
+func isValidVKN(val string) bool {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		t := (int(val[i]-'0') + 9 - i) % 10
+		if t == 0 {
+			continue
+		}
+		v := (t << (9 - i)) % 9
+		if v == 0 {
+			v = 9
+		}
+		sum += v
+	}
+	return (10-sum%10)%10 == int(val[9]-'0')
+}
+
+// isValidTCKN checks the two trailing digits of an 11 digit TCKN.
+func isValidTCKN(val string) bool {
+	d := make([]int, 11)
+	for i := range d {
+		d[i] = int(val[i] - '0')
+	}
+	odd := d[0] + d[2] + d[4] + d[6] + d[8]
+	even := d[1] + d[3] + d[5] + d[7]
+	if ((odd*7-even)%10+10)%10 != d[9] {
+		return false
+	}
+	sum := 0
+	for _, n := range d[:10] {
+		sum += n
+	}
+	return sum%10 == d[10]
+}
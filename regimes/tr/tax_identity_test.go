@@ -0,0 +1,116 @@
+package tr_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/norm"
+	_ "github.com/invopop/gobl/regimes/tr"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCode    cbc.Code
+		expectedCode cbc.Code
+	}{
+		{
+			name:         "strips TR prefix and spaces",
+			inputCode:    "TR 470 009 9607",
+			expectedCode: "4700099607",
+		},
+		{
+			name:         "already normalized",
+			inputCode:    "4700099607",
+			expectedCode: "4700099607",
+		},
+		{
+			name:         "empty",
+			inputCode:    "",
+			expectedCode: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "TR", Code: tt.inputCode}
+			norm.Normalize(tID)
+			assert.Equal(t, tt.expectedCode, tID.Code)
+		})
+	}
+
+	t.Run("nil identity", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			norm.Normalize((*tax.Identity)(nil))
+		})
+	})
+}
+
+func TestValidateTaxIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputCode   cbc.Code
+		expectedErr string
+	}{
+		{
+			name:      "valid VKN",
+			inputCode: "4700099607",
+		},
+		{
+			name:      "valid VKN 2",
+			inputCode: "1234567890",
+		},
+		{
+			name:      "valid VKN leading zero",
+			inputCode: "0123456789",
+		},
+		{
+			name:      "valid TCKN",
+			inputCode: "10000000146",
+		},
+		{
+			name:      "valid TCKN 2",
+			inputCode: "12345678950",
+		},
+		{
+			name:        "bad VKN checksum",
+			inputCode:   "4700099608",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad TCKN tenth digit",
+			inputCode:   "10000000156",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "bad TCKN last digit",
+			inputCode:   "10000000147",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "TCKN leading zero",
+			inputCode:   "01234567890",
+			expectedErr: "IDENTITY-01",
+		},
+		{
+			name:        "too short",
+			inputCode:   "123456789",
+			expectedErr: "IDENTITY-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tID := &tax.Identity{Country: "TR", Code: tt.inputCode}
+			err := rules.Validate(tID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
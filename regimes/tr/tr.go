@@ -0,0 +1,92 @@
+// Package tr provides the tax regime definition for Turkey.
+package tr
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for Turkey.
+const CountryCode = "TR"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("tr", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxComboRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.TRY,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "Turkey",
+			i18n.TR: "Türkiye",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				Turkey's tax system is administered by the Revenue Administration
+				(GİB, Gelir İdaresi Başkanlığı). KDV (Katma Değer Vergisi) is charged
+				at a general rate with reduced and super-reduced rates for basic
+				goods and services.
+
+				Companies are identified by a 10 digit VKN (Vergi Kimlik Numarası)
+				and individuals by their 11 digit TCKN (T.C. Kimlik Numarası), both
+				including check digits.
+
+				Certain services and deliveries are subject to partial or full KDV
+				withholding (tevkifat), where the customer retains a share of the
+				KDV and pays it directly to the tax office. Withholding is modelled
+				with the retained ~KDVT~ category, whose percent is the withheld
+				share of the KDV rate, and the ~tr-tevkifat-code~ extension with
+				the official code and ratio.
+
+				Taxpayers registered with the e-Fatura system exchange invoices with
+				each other through GİB, using the basic (~TEMELFATURA~) or, with the
+				~commercial~ tag, the commercial (~TICARIFATURA~) profile. Invoices
+				for customers outside the system, including consumers, are issued as
+				e-Arşiv invoices with the ~e-archive~ or ~simplified~ tags. The
+				profile and invoice type are set in the ~tr-profile~ and
+				~tr-invoice-type~ extensions.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("GİB - e-Fatura"),
+				URL:   "https://ebelge.gib.gov.tr/efaturamevzuat.html",
+			},
+			{
+				Title: i18n.NewString("GİB - KDV Genel Uygulama Tebliği"),
+				URL:   "https://www.gib.gov.tr/node/87593",
+			},
+		},
+		TimeZone:   "Europe/Istanbul",
+		Tags:       []*tax.TagSet{invoiceTags},
+		Extensions: extensions,
+		Scenarios:  []*tax.ScenarioSet{invoiceScenarios},
+		Categories: taxCategories,
+		// Returns (iade faturası) are issued as credit notes.
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+				},
+			},
+		},
+	}
+}
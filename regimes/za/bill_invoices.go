@@ -0,0 +1,77 @@
+package za
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+// abridgedInvoiceLimit is the maximum consideration, including VAT, that
+// may be documented with an abridged tax invoice.
+var abridgedInvoiceLimit = num.MakeAmount(5000, 0)
+
+func billInvoiceRules() *rules.Set {
+	return rules.For(new(bill.Invoice),
+		rules.When(
+			is.InContext(tax.RegimeIn(CountryCode)),
+			rules.Field("supplier",
+				rules.Field("tax_id",
+					rules.Assert("01", "invoice supplier tax ID is required", is.Present),
+					rules.Field("code",
+						rules.Assert("02", "invoice supplier VAT number is required", is.Present),
+					),
+				),
+				rules.Field("addresses",
+					rules.Assert("03", "invoice supplier address is required", is.Present),
+				),
+			),
+			// Full tax invoices
+			rules.When(is.Func("full tax invoice", isFullTaxInvoice),
+				rules.Field("customer",
+					rules.Assert("04", "invoice customer is required for full tax invoices", is.Present),
+					rules.Field("addresses",
+						rules.Assert("05", "invoice customer address is required for full tax invoices", is.Present),
+					),
+				),
+			),
+			// Abridged tax invoices
+			rules.When(is.Func("abridged tax invoice", isAbridgedTaxInvoice),
+				rules.Assert("06", "abridged tax invoices are limited to a consideration of R5,000",
+					is.Func("within abridged limit", isWithinAbridgedLimit),
+				),
+			),
+			rules.When(
+				bill.InvoiceTypeIn(bill.InvoiceTypeCreditNote, bill.InvoiceTypeDebitNote),
+				rules.Field("preceding",
+					rules.Assert("07", "preceding documents are required for credit and debit notes", is.Present),
+				),
+			),
+		),
+	)
+}
+
+func isFullTaxInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && !inv.HasTags(tax.TagSimplified)
+}
+
+func isAbridgedTaxInvoice(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	return ok && inv != nil && inv.HasTags(tax.TagSimplified)
+}
+
+// isWithinAbridgedLimit checks the total of invoices in rand, as the limit
+// cannot be reliably compared for other currencies.
+func isWithinAbridgedLimit(val any) bool {
+	inv, ok := val.(*bill.Invoice)
+	if !ok || inv == nil || inv.Totals == nil {
+		return true
+	}
+	if inv.Currency != "" && inv.Currency != currency.ZAR {
+		return true
+	}
+	return inv.Totals.TotalWithTax.Compare(abridgedInvoiceLimit) <= 0
+}
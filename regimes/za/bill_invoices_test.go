@@ -0,0 +1,174 @@
+package za_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/regimes/za"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Regime:    tax.WithRegime(za.CountryCode),
+		Code:      "INV-001",
+		IssueDate: cal.MakeDate(2024, 6, 1),
+		Supplier: &org.Party{
+			Name: "Supplier (Pty) Ltd",
+			TaxID: &tax.Identity{
+				Country: "ZA",
+				Code:    "4123456784",
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Long Street",
+					Number:   "10",
+					Locality: "Cape Town",
+					Code:     "8001",
+				},
+			},
+		},
+		Customer: &org.Party{
+			Name: "Customer (Pty) Ltd",
+			TaxID: &tax.Identity{
+				Country: "ZA",
+				Code:    "4700123450",
+			},
+			Addresses: []*org.Address{
+				{
+					Street:   "Jan Smuts Avenue",
+					Number:   "1",
+					Locality: "Johannesburg",
+					Code:     "2196",
+				},
+			},
+		},
+		Lines: []*bill.Line{
+			{
+				Quantity: num.MakeAmount(10, 0),
+				Item: &org.Item{
+					Name:  "Consulting services",
+					Price: num.NewAmount(100000, 2),
+				},
+				Taxes: tax.Set{
+					{Category: tax.CategoryVAT, Rate: tax.RateGeneral},
+				},
+			},
+		},
+	}
+}
+
+func TestInvoiceTaxRates(t *testing.T) {
+	tests := []struct {
+		name string
+		date cal.Date
+		rate cbc.Key
+		tax  string
+		err  string
+	}{
+		{
+			name: "general",
+			date: cal.MakeDate(2024, 6, 1),
+			rate: tax.RateGeneral,
+			tax:  "1500.00",
+		},
+		{
+			name: "general before April 2018",
+			date: cal.MakeDate(2018, 3, 31),
+			rate: tax.RateGeneral,
+			tax:  "1400.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := validInvoice()
+			inv.IssueDate = tt.date
+			inv.Lines[0].Taxes[0].Rate = tt.rate
+			err := inv.Calculate()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.tax, inv.Totals.Tax.String())
+		})
+	}
+}
+
+func TestFullTaxInvoice(t *testing.T) {
+	t.Run("missing supplier address", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.Addresses = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-03]")
+	})
+	t.Run("missing customer", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-04]")
+	})
+	t.Run("missing customer address", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.Addresses = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-05]")
+	})
+	t.Run("customer without VAT number", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Customer.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+}
+
+func TestAbridgedTaxInvoice(t *testing.T) {
+	t.Run("consideration limit", func(t *testing.T) {
+		inv := validInvoice()
+		inv.SetTags(tax.TagSimplified)
+		inv.Customer = nil
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "11500.00", inv.Totals.TotalWithTax.String())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-06]")
+
+		inv.Lines[0].Quantity = num.MakeAmount(4, 0)
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "4600.00", inv.Totals.TotalWithTax.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+}
+
+func TestInvoiceValidation(t *testing.T) {
+	t.Run("normal invoice", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		assert.Equal(t, "ZAR", inv.Currency.String())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("missing supplier tax ID", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Supplier.TaxID = nil
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-01]")
+	})
+	t.Run("credit note", func(t *testing.T) {
+		inv := validInvoice()
+		require.NoError(t, inv.Calculate())
+		require.NoError(t, inv.Correct(bill.Credit, bill.WithReason("Goods returned")))
+		require.NoError(t, inv.Calculate())
+		assert.NoError(t, rules.Validate(inv))
+	})
+	t.Run("credit note without preceding", func(t *testing.T) {
+		inv := validInvoice()
+		inv.Type = bill.InvoiceTypeCreditNote
+		require.NoError(t, inv.Calculate())
+		assert.ErrorContains(t, rules.Validate(inv), "[GOBL-ZA-BILL-INVOICE-07]")
+	})
+}
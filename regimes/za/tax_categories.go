@@ -0,0 +1,58 @@
+package za
+
+import (
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+)
+
+var taxCategories = []*tax.CategoryDef{
+	//
+	// VAT
+	//
+	{
+		Code: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "VAT",
+			i18n.AF: "BTW",
+		},
+		Title: i18n.String{
+			i18n.EN: "Value Added Tax",
+			i18n.AF: "Belasting op Toegevoegde Waarde",
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SARS - VAT rate increase"),
+				URL:   "https://www.sars.gov.za/types-of-tax/value-added-tax/vat-rate-increase/",
+			},
+		},
+		Retained: false,
+		Keys:     tax.GlobalVATKeys(),
+		Rates: []*tax.RateDef{
+			{
+				Keys: []cbc.Key{tax.KeyStandard},
+				Rate: tax.RateGeneral,
+				Name: i18n.String{
+					i18n.EN: "Standard Rate",
+					i18n.AF: "Standaardkoers",
+				},
+				Values: []*tax.RateValueDef{
+					{
+						Since:   cal.NewDate(2018, 4, 1),
+						Percent: num.MakePercentage(150, 3),
+					},
+					{
+						Since:   cal.NewDate(1993, 4, 7),
+						Percent: num.MakePercentage(140, 3),
+					},
+					{
+						Since:   cal.NewDate(1991, 9, 30),
+						Percent: num.MakePercentage(100, 3),
+					},
+				},
+			},
+		},
+	},
+}
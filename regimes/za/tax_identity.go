@@ -0,0 +1,33 @@
+package za
+
+import (
+	"regexp"
+
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/pkg/luhn"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/tax"
+)
+
+var vatNumberRegexp = regexp.MustCompile(`^4\d{9}$`)
+
+func taxIdentityRules() *rules.Set {
+	return rules.For(new(tax.Identity),
+		rules.When(tax.IdentityIn(CountryCode),
+			rules.Field("code",
+				rules.AssertIfPresent("01", "invalid South African VAT number",
+					is.Func("valid VAT number", isValidVATNumber),
+				),
+			),
+		),
+	)
+}
+
+func isValidVATNumber(value any) bool {
+	code, ok := value.(cbc.Code)
+	if !ok || code == "" {
+		return false
+	}
+	return vatNumberRegexp.MatchString(code.String()) && luhn.Check(code)
+}
@@ -0,0 +1,83 @@
+// Package za provides the tax regime definition for South Africa.
+package za
+
+import (
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/norm"
+	"github.com/invopop/gobl/pkg/here"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/tax"
+)
+
+// CountryCode is the tax country code for South Africa.
+const CountryCode = "ZA"
+
+func init() {
+	tax.RegisterRegimeDef(New())
+	rules.Register("za", rules.GOBL.Add(CountryCode),
+		billInvoiceRules(),
+		taxIdentityRules(),
+	)
+	norm.Register(
+		norm.When(tax.IdentityIn(CountryCode), norm.For(func(id *tax.Identity) { tax.NormalizeIdentity(id) })),
+	)
+}
+
+// New provides the tax region definition
+func New() *tax.RegimeDef {
+	return &tax.RegimeDef{
+		Country:   CountryCode,
+		Currency:  currency.ZAR,
+		TaxScheme: tax.CategoryVAT,
+		Name: i18n.String{
+			i18n.EN: "South Africa",
+			i18n.AF: "Suid-Afrika",
+		},
+		Description: i18n.String{
+			i18n.EN: here.Doc(`
+				South Africa's tax system is administered by the South African
+				Revenue Service (SARS). VAT is charged at a single standard rate,
+				increased from 14% to 15% on 1 April 2018, with zero-rated and
+				exempt supplies defined in the VAT Act No. 89 of 1991.
+
+				Vendors are identified by a 10 digit VAT registration number
+				starting with 4 and validated with the Luhn algorithm.
+
+				Section 20 of the VAT Act defines the contents of tax invoices. A
+				full tax invoice must include the name, address and VAT number of
+				the supplier, and the name and address of the recipient, together
+				with the recipient's VAT number if they are a registered vendor.
+				Supplies with a consideration of R5,000 or less may instead be
+				documented with an abridged tax invoice, issued in GOBL with the
+				~simplified~ tag, that does not require the recipient's details.
+				Credit and debit notes, defined in section 21, must reference the
+				original tax invoice.
+			`),
+		},
+		Sources: []*cbc.Source{
+			{
+				Title: i18n.NewString("SARS - VAT"),
+				URL:   "https://www.sars.gov.za/types-of-tax/value-added-tax/",
+			},
+			{
+				Title: i18n.NewString("SARS - VAT 404 Guide for Vendors"),
+				URL:   "https://www.sars.gov.za/wp-content/uploads/Ops/Guides/LAPD-VAT-G02-VAT-404-Guide-for-Vendors.pdf",
+			},
+		},
+		TimeZone:   "Africa/Johannesburg",
+		Categories: taxCategories,
+		Corrections: []*tax.CorrectionDefinition{
+			{
+				Schema: bill.ShortSchemaInvoice,
+				Types: []cbc.Key{
+					bill.InvoiceTypeCreditNote,
+					bill.InvoiceTypeDebitNote,
+				},
+				ReasonRequired: true,
+			},
+		},
+	}
+}
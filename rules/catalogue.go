@@ -0,0 +1,181 @@
+package rules
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/civil"
+)
+
+// businessRulePattern matches business rule codes like those defined in
+// EN 16931 (e.g. "BR-16", "BR-CO-25"), national specifications (e.g.
+// "BR-DE-15"), Peppol BIS Billing (e.g. "PEPPOL-EN16931-R003") or the
+// Peppol national rules (e.g. "NO-R-001"), which by convention are included
+// in assertion descriptions.
+var businessRulePattern = regexp.MustCompile(`\b(?:BR-[A-Z0-9]+(?:-[A-Z0-9]+)*|PEPPOL-[A-Z0-9]+-[A-Z]+[0-9]+|[A-Z]{2}-R-[0-9]+)\b`)
+
+// Catalogue is a flat, machine-readable listing of rule assertions, similar in
+// spirit to a Schematron schema. Each entry describes the context in which an
+// assertion is evaluated, the guards that must pass, and the test applied,
+// making the rules easier to compare with other validation specifications.
+type Catalogue struct {
+	// Rules lists the assertions, in the order they were defined.
+	Rules []*CatalogueRule `json:"rules"`
+	// BusinessRules maps business rule codes (e.g. EN 16931 "BR-16" or Peppol
+	// "PEPPOL-EN16931-R003") referenced by assertions to the codes of the
+	// assertions that implement them.
+	BusinessRules map[string][]Code `json:"business_rules,omitempty"`
+}
+
+// CatalogueRule describes a single assertion and the context in which it
+// is evaluated.
+type CatalogueRule struct {
+	// Code is the fully-qualified assertion code.
+	Code Code `json:"code"`
+	// Desc is the assertion's description.
+	Desc string `json:"desc,omitempty"`
+	// Severity of the faults produced by the assertion.
+	Severity Severity `json:"severity"`
+	// Package is the name of the registered set the assertion belongs to,
+	// e.g. "bill" or "eu-en16931".
+	Package string `json:"package,omitempty"`
+	// Object is the Go type name the assertion applies to, e.g. "bill.Invoice".
+	Object string `json:"object,omitempty"`
+	// Path is the dot-separated list of JSON field names inside the object
+	// leading to the value tested, with a "[]" suffix for each element of a
+	// slice. Empty when the object itself is tested.
+	Path string `json:"path,omitempty"`
+	// Guards lists the conditions, from outermost to innermost, that must be
+	// met for the assertion to be evaluated.
+	Guards []string `json:"guards,omitempty"`
+	// Tests is the string representation of the tests applied to the value.
+	Tests string `json:"tests"`
+	// Since is the date from which the assertion applies, if any.
+	Since *civil.Date `json:"since,omitempty"`
+	// Until is the last date on which the assertion applies, if any.
+	Until *civil.Date `json:"until,omitempty"`
+	// Fixable is true when the assertion defines a fixer.
+	Fixable bool `json:"fixable,omitempty"`
+	// BusinessRules lists business rule codes referenced by the description.
+	BusinessRules []string `json:"business_rules,omitempty"`
+}
+
+// NewCatalogue builds a catalogue from the provided sets, or from all the sets
+// in the global registry, ordered by package, when none are provided.
+func NewCatalogue(sets ...*Set) *Catalogue {
+	if len(sets) == 0 {
+		sets = registeredSets()
+	}
+	c := &Catalogue{Rules: make([]*CatalogueRule, 0)}
+	for _, s := range sets {
+		c.add(s, catalogueScope{})
+	}
+	return c
+}
+
+// BusinessRule returns the codes of the assertions that reference the given
+// business rule code.
+func (c *Catalogue) BusinessRule(br string) []Code {
+	return c.BusinessRules[br]
+}
+
+// registeredSets returns the sets in the global registry without duplicates
+// and sorted by package for a stable output.
+func registeredSets() []*Set {
+	var sets []*Set
+	for _, s := range allSets() {
+		if !slices.Contains(sets, s) {
+			sets = append(sets, s)
+		}
+	}
+	slices.SortStableFunc(sets, func(a, b *Set) int {
+		return strings.Compare(a.Package, b.Package)
+	})
+	return sets
+}
+
+// catalogueScope accumulates the context inherited from parent sets.
+type catalogueScope struct {
+	pkg    string
+	object string
+	path   string
+	guards []string
+	since  *civil.Date
+	until  *civil.Date
+}
+
+func (c *Catalogue) add(s *Set, sc catalogueScope) {
+	if s.Package != "" {
+		sc.pkg = s.Package
+	}
+	if s.Object != "" {
+		sc.object = s.Object
+		sc.path = ""
+	}
+	if s.FieldName != "" {
+		sc.path = catalogueJoin(sc.path, s.FieldName)
+	}
+	if s.Each {
+		sc.path += "[]"
+	}
+	if s.Guard != nil {
+		sc.guards = append(slices.Clip(sc.guards), s.Guard.String())
+	}
+	if s.Since != nil {
+		sc.since = s.Since
+	}
+	if s.Until != nil {
+		sc.until = s.Until
+	}
+	for _, a := range s.Assert {
+		c.addAssertion(a, sc)
+	}
+	for _, ss := range s.Subsets {
+		c.add(ss, sc)
+	}
+}
+
+func (c *Catalogue) addAssertion(a *Assertion, sc catalogueScope) {
+	parts := make([]string, len(a.Tests))
+	for i, t := range a.Tests {
+		parts[i] = t.String()
+	}
+	r := &CatalogueRule{
+		Code:     a.ID,
+		Desc:     a.Desc,
+		Severity: a.Severity.level(),
+		Package:  sc.pkg,
+		Object:   sc.object,
+		Path:     sc.path,
+		Guards:   sc.guards,
+		Tests:    strings.Join(parts, ", "),
+		Since:    sc.since,
+		Until:    sc.until,
+		Fixable:  a.Fix != nil,
+	}
+	if a.Since != nil {
+		r.Since = a.Since
+	}
+	if a.Until != nil {
+		r.Until = a.Until
+	}
+	for _, br := range businessRulePattern.FindAllString(a.Desc, -1) {
+		if slices.Contains(r.BusinessRules, br) {
+			continue
+		}
+		r.BusinessRules = append(r.BusinessRules, br)
+		if c.BusinessRules == nil {
+			c.BusinessRules = make(map[string][]Code)
+		}
+		c.BusinessRules[br] = append(c.BusinessRules[br], a.ID)
+	}
+	c.Rules = append(c.Rules, r)
+}
+
+func catalogueJoin(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
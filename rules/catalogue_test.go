@@ -0,0 +1,96 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func catalogueRules() *rules.Set {
+	return rules.NewSet("CAT",
+		rules.For(new(Person),
+			rules.Field("name",
+				rules.Assert("01", "name is required (BR-6)", is.Present),
+			),
+			rules.When(is.Expr(`Age >= 18`),
+				rules.Field("emails",
+					rules.Each(
+						rules.Field("addr",
+							rules.Assert("02", "adult email is required (BR-6, BR-CO-9)", is.Present),
+						),
+					),
+				),
+			),
+			rules.Since("2026-01-01",
+				rules.Warn(
+					rules.Assert("03", "age is recommended (BR-CO-9)", is.Expr(`Age > 0`)),
+				),
+			),
+		),
+	)
+}
+
+func TestNewCatalogue(t *testing.T) {
+	c := rules.NewCatalogue(catalogueRules())
+	require.Len(t, c.Rules, 3)
+
+	// Assertions on the object come before those in subsets.
+	r := c.Rules[0]
+	assert.Equal(t, rules.Code("CAT-PERSON-03"), r.Code)
+	assert.Equal(t, "", r.Path)
+	assert.Equal(t, "Age > 0", r.Tests)
+	assert.Equal(t, rules.SeverityWarning, r.Severity)
+	require.NotNil(t, r.Since)
+	assert.Equal(t, "2026-01-01", r.Since.String())
+
+	r = c.Rules[1]
+	assert.Equal(t, rules.Code("CAT-PERSON-01"), r.Code)
+	assert.Equal(t, "rules.Person", r.Object)
+	assert.Equal(t, "name", r.Path)
+	assert.Empty(t, r.Guards)
+	assert.Equal(t, "present", r.Tests)
+	assert.Equal(t, rules.SeverityError, r.Severity)
+	assert.Equal(t, []string{"BR-6"}, r.BusinessRules)
+
+	r = c.Rules[2]
+	assert.Equal(t, "emails[].addr", r.Path)
+	assert.Equal(t, []string{"Age >= 18"}, r.Guards)
+	assert.Equal(t, []string{"BR-6", "BR-CO-9"}, r.BusinessRules)
+
+	assert.Equal(t, []rules.Code{"CAT-PERSON-01", "CAT-PERSON-02"}, c.BusinessRule("BR-6"))
+	assert.Equal(t, []rules.Code{"CAT-PERSON-03", "CAT-PERSON-02"}, c.BusinessRule("BR-CO-9"))
+	assert.Nil(t, c.BusinessRule("BR-1"))
+
+	data, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"business_rules":{"BR-6":["CAT-PERSON-01","CAT-PERSON-02"]`)
+}
+
+func TestCatalogueBusinessRulePatterns(t *testing.T) {
+	set := rules.For(new(Person),
+		rules.Assert("01", "name is required (PEPPOL-EN16931-R003, NO-R-001)", is.Expr(`Name != ""`)),
+		rules.Assert("02", "code list value (PEPPOL-EN16931-CL008, BR-DE-15)", is.Expr(`Age > 0`)),
+		rules.Assert("03", "no references (BT-23, R-001)", is.Expr(`Age > 0`)),
+	)
+	c := rules.NewCatalogue(set)
+	require.Len(t, c.Rules, 3)
+	assert.Equal(t, []string{"PEPPOL-EN16931-R003", "NO-R-001"}, c.Rules[0].BusinessRules)
+	assert.Equal(t, []string{"PEPPOL-EN16931-CL008", "BR-DE-15"}, c.Rules[1].BusinessRules)
+	assert.Empty(t, c.Rules[2].BusinessRules)
+}
+
+func TestNewCatalogueRegistry(t *testing.T) {
+	c := rules.NewCatalogue()
+	var found bool
+	for _, r := range c.Rules {
+		if r.Code == "GOBL-TEST-PERSON-01" {
+			found = true
+			assert.Equal(t, "test", r.Package)
+		}
+	}
+	assert.True(t, found)
+}
@@ -20,6 +20,17 @@ type contextEntry struct {
 type Context struct {
 	entries []contextEntry
 	ignores []Code
+	sets    []*Set
+
+	// trace state, only used when a Trace is provided with WithTrace
+	trace  *Trace
+	path   []string
+	setIDs []Code
+
+	// fix state, only used by Fix
+	fix     bool
+	target  reflect.Value
+	parents []reflect.Value
 }
 
 // addIgnores records fault codes to be suppressed from the validation result.
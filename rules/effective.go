@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/civil"
+)
+
+// ContextKeyDate is the validation context key for the date used to evaluate
+// the effective dates of sets and assertions, typically the issue date of the
+// document being validated.
+const ContextKeyDate ContextKey = "date"
+
+// WithDate returns a validation option that sets the date used to evaluate
+// effective-dated rules. Documents with an issue date will usually provide
+// it automatically through their own rules context.
+func WithDate(date civil.Date) WithContext {
+	return func(rc *Context) {
+		rc.Set(ContextKeyDate, date)
+	}
+}
+
+// Since returns a Def that applies the wrapped definitions only when the
+// date in the validation context, in "YYYY-MM-DD" format, is on or after the
+// date provided. This allows upcoming requirements to be defined ahead of time
+// without affecting older documents:
+//
+//	rules.Since("2026-01-01",
+//	    rules.Field("ordering",
+//	        rules.Assert("12", "ordering details are required", is.Present),
+//	    ),
+//	)
+//
+// The dates are assigned to the assertions and subsets created by the wrapped
+// definitions. When no date is available in the context, the rules are always
+// applied.
+func Since(date string, defs ...Def) Def {
+	d := mustParseDate(date)
+	return withDates(&d, nil, defs...)
+}
+
+// Until returns a Def that applies the wrapped definitions only when the
+// date in the validation context is on or before the date provided, in
+// "YYYY-MM-DD" format. Use it to retire rules that no longer apply to new
+// documents.
+func Until(date string, defs ...Def) Def {
+	d := mustParseDate(date)
+	return withDates(nil, &d, defs...)
+}
+
+func mustParseDate(date string) civil.Date {
+	d, err := civil.ParseDate(date)
+	if err != nil {
+		panic(fmt.Sprintf("rules: invalid effective date %q: %s", date, err.Error()))
+	}
+	return d
+}
+
+// withDates assigns the effective dates to the assertions and subsets added
+// by the definitions. Dates already assigned by nested definitions are
+// combined so that the period only narrows, using the later since and the
+// earlier until date.
+func withDates(since, until *civil.Date, defs ...Def) Def {
+	return func(s *Set) {
+		na, ns := len(s.Assert), len(s.Subsets)
+		for _, def := range defs {
+			def(s)
+		}
+		for _, a := range s.Assert[na:] {
+			a.Since = laterDate(a.Since, since)
+			a.Until = earlierDate(a.Until, until)
+		}
+		for _, ss := range s.Subsets[ns:] {
+			ss.Since = laterDate(ss.Since, since)
+			ss.Until = earlierDate(ss.Until, until)
+		}
+	}
+}
+
+func laterDate(a, b *civil.Date) *civil.Date {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+func earlierDate(a, b *civil.Date) *civil.Date {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
+
+// date returns the date to use to evaluate effective dates, if any.
+func (c *Context) date() (civil.Date, bool) {
+	if c == nil {
+		return civil.Date{}, false
+	}
+	d, ok := c.Value(ContextKeyDate).(civil.Date)
+	return d, ok && d.IsValid()
+}
+
+// effectiveOn reports whether the period defined by since and until includes
+// the date in the context.
+func effectiveOn(rc *Context, since, until *civil.Date) bool {
+	if since == nil && until == nil {
+		return true
+	}
+	d, ok := rc.date()
+	if !ok {
+		return true
+	}
+	if since != nil && d.Before(*since) {
+		return false
+	}
+	if until != nil && d.After(*until) {
+		return false
+	}
+	return true
+}
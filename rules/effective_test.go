@@ -0,0 +1,120 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func effectiveRules() *rules.Set {
+	return rules.NewSet("EFF",
+		rules.For(new(Person),
+			rules.Field("name",
+				rules.Assert("01", "name is required", is.Present),
+			),
+			rules.Since("2026-01-01",
+				rules.Field("address",
+					rules.Assert("02", "address is required", is.Present),
+				),
+				rules.Assert("03", "age is required", is.Expr(`Age > 0`)),
+			),
+			rules.Until("2025-12-31",
+				rules.Field("emails",
+					rules.Assert("04", "emails are required", is.Present),
+				),
+			),
+			rules.Since("2025-01-01",
+				rules.Until("2025-06-30",
+					rules.Assert("05", "name must not be Sam", is.Expr(`Name != "Sam"`)),
+				),
+			),
+		),
+	)
+}
+
+func TestEffectiveDates(t *testing.T) {
+	set := effectiveRules()
+	p := &Person{Name: "Sam"}
+	codes := func(fs rules.Faults) []rules.Code {
+		var out []rules.Code
+		if fs == nil {
+			return out
+		}
+		for _, f := range fs.List() {
+			out = append(out, f.Code())
+		}
+		return out
+	}
+
+	t.Run("no date applies all rules", func(t *testing.T) {
+		fs := set.Validate(p)
+		assert.ElementsMatch(t, []rules.Code{"EFF-PERSON-02", "EFF-PERSON-03", "EFF-PERSON-04", "EFF-PERSON-05"}, codes(fs))
+	})
+	t.Run("before since", func(t *testing.T) {
+		fs := set.Validate(p, rules.WithDate(civil.Date{Year: 2024, Month: 12, Day: 31}))
+		assert.ElementsMatch(t, []rules.Code{"EFF-PERSON-04"}, codes(fs))
+	})
+	t.Run("within period", func(t *testing.T) {
+		fs := set.Validate(p, rules.WithDate(civil.Date{Year: 2025, Month: 6, Day: 30}))
+		assert.ElementsMatch(t, []rules.Code{"EFF-PERSON-04", "EFF-PERSON-05"}, codes(fs))
+	})
+	t.Run("on since date", func(t *testing.T) {
+		fs := set.Validate(p, rules.WithDate(civil.Date{Year: 2026, Month: 1, Day: 1}))
+		assert.ElementsMatch(t, []rules.Code{"EFF-PERSON-02", "EFF-PERSON-03"}, codes(fs))
+	})
+	t.Run("on until date", func(t *testing.T) {
+		fs := set.Validate(p, rules.WithDate(civil.Date{Year: 2025, Month: 12, Day: 31}))
+		assert.ElementsMatch(t, []rules.Code{"EFF-PERSON-04"}, codes(fs))
+	})
+	t.Run("trace", func(t *testing.T) {
+		tr := rules.NewTrace()
+		set.Validate(p, rules.WithDate(civil.Date{Year: 2025, Month: 3, Day: 1}), rules.WithTrace(tr))
+		ts := tr.Path("$.address")
+		require.NotNil(t, ts)
+		assert.Equal(t, rules.TraceInactive, ts.Sets[0].Status)
+	})
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(set)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"field":"address","since":"2026-01-01"`)
+		assert.Contains(t, string(data), `"id":"EFF-PERSON-03","desc":"age is required","tests":"Age \u003e 0","since":"2026-01-01"`)
+		assert.Contains(t, string(data), `"id":"EFF-PERSON-05","desc":"name must not be Sam","tests":"Name != \"Sam\"","since":"2025-01-01","until":"2025-06-30"`)
+	})
+	t.Run("nested dates narrow the period", func(t *testing.T) {
+		set := rules.For(new(Person),
+			rules.Since("2025-01-01",
+				rules.Since("2024-01-01",
+					rules.Assert("01", "name must not be Sam", is.Expr(`Name != "Sam"`)),
+				),
+				rules.Since("2026-01-01",
+					rules.Assert("02", "name must not be Sam", is.Expr(`Name != "Sam"`)),
+				),
+			),
+			rules.Until("2025-06-30",
+				rules.Until("2025-12-31",
+					rules.Assert("03", "name must not be Sam", is.Expr(`Name != "Sam"`)),
+				),
+			),
+		)
+		a := set.Assert
+		require.Len(t, a, 3)
+		assert.Equal(t, "2025-01-01", a[0].Since.String())
+		assert.Equal(t, "2026-01-01", a[1].Since.String())
+		assert.Equal(t, "2025-06-30", a[2].Until.String())
+
+		fs := set.Validate(p, rules.WithDate(civil.Date{Year: 2024, Month: 6, Day: 1}))
+		assert.ElementsMatch(t, []rules.Code{"RULES-PERSON-03"}, codes(fs))
+		fs = set.Validate(p, rules.WithDate(civil.Date{Year: 2025, Month: 9, Day: 1}))
+		assert.ElementsMatch(t, []rules.Code{"RULES-PERSON-01"}, codes(fs))
+	})
+	t.Run("invalid date", func(t *testing.T) {
+		assert.PanicsWithValue(t, `rules: invalid effective date "2026-13-01": parsing time "2026-13-01": month out of range`, func() {
+			rules.Since("2026-13-01")
+		})
+	})
+}
@@ -4,22 +4,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/invopop/gobl/i18n"
 )
 
 // Fault represents a single rule assertion failure identified by a code and one or
-// more paths. When multiple paths share the same code and message, they are merged
-// into a single Fault. Fault is *not* designed to be instantiated directly, and
-// will be created as part of the validation processes from defined rules.
+// more paths, along with the severity of the assertion. When multiple paths share
+// the same code and message, they are merged into a single Fault. Fault is *not*
+// designed to be instantiated directly, and will be created as part of the
+// validation processes from defined rules.
 //
 //nolint:errname
 type Fault struct {
-	paths   []string
-	code    Code
-	message string
+	paths    []string
+	code     Code
+	message  string
+	texts    i18n.String
+	severity Severity
+	fixable  bool
+	fixed    bool
+}
+
+func newFault(path string, id Code, message string, sev Severity) *Fault {
+	return &Fault{paths: []string{path}, code: id, message: message, severity: sev.level()}
 }
 
-func newFault(path string, id Code, message string) *Fault {
-	return &Fault{paths: []string{path}, code: id, message: message}
+// withTexts sets the translations of the message provided by the assertion.
+func (f *Fault) withTexts(texts i18n.String) *Fault {
+	f.texts = texts
+	return f
 }
 
 // Paths returns the JSON Path (RFC 6901) locations where this fault occurred.
@@ -41,6 +54,37 @@ func (f *Fault) Message() string {
 	return f.message
 }
 
+// MessageIn returns the message translated into the requested language. The
+// translations defined by the assertion take priority over those registered in
+// the catalogue with RegisterTranslations. The default message is returned when
+// no translation is available.
+func (f *Fault) MessageIn(lang i18n.Lang) string {
+	if msg, ok := f.texts[lang]; ok {
+		return msg
+	}
+	if msg, ok := Translation(f.code, lang); ok {
+		return msg
+	}
+	return f.message
+}
+
+// Severity returns the severity of the assertion that produced this fault.
+func (f *Fault) Severity() Severity {
+	return f.severity.level()
+}
+
+// Fixable reports whether the assertion that produced this fault defines a
+// fixer, so that Fix may be able to correct the problem automatically.
+func (f *Fault) Fixable() bool {
+	return f.fixable
+}
+
+// IsError reports whether the fault has an error severity, and so makes
+// the validated object invalid.
+func (f *Fault) IsError() bool {
+	return f.Severity() == SeverityError
+}
+
 // Error implements the error interface.
 func (f *Fault) Error() string {
 	var pathPart string
@@ -57,17 +101,20 @@ func (f *Fault) Error() string {
 	return fmt.Sprintf("[%s] %s", f.code, pathPart+f.message)
 }
 
-// MarshalJSON encodes the fault as a JSON object with paths, code, and message fields.
+// MarshalJSON encodes the fault as a JSON object with code, paths, message,
+// and severity fields, along with the fixable flag when set.
 func (f *Fault) MarshalJSON() ([]byte, error) {
 	paths := make([]string, len(f.paths))
 	for i, p := range f.paths {
 		paths[i] = publicPath(p)
 	}
 	return json.Marshal(struct {
-		Code    Code     `json:"code"`
-		Paths   []string `json:"paths"`
-		Message string   `json:"message"`
-	}{f.code, paths, f.message})
+		Code     Code     `json:"code"`
+		Paths    []string `json:"paths"`
+		Message  string   `json:"message"`
+		Severity Severity `json:"severity"`
+		Fixable  bool     `json:"fixable,omitempty"`
+	}{f.code, paths, f.message, f.Severity(), f.fixable})
 }
 
 // Faults is the interface for a collection of validation faults.
@@ -86,6 +133,14 @@ type Faults interface {
 	At(i int) *Fault
 	// List returns the underlying slice of faults.
 	List() []*Fault
+	// HasErrors reports whether any fault has an error severity.
+	HasErrors() bool
+	// Errors returns only the faults with an error severity, or nil if
+	// there are none.
+	Errors() Faults
+	// Warnings returns the faults with a warning or info severity, which
+	// do not make the object invalid, or nil if there are none.
+	Warnings() Faults
 }
 
 // faultList is the concrete slice-based implementation of Faults.
@@ -166,25 +221,66 @@ func (fs faultList) List() []*Fault {
 	return []*Fault(fs)
 }
 
+// HasErrors reports whether any fault has an error severity.
+func (fs faultList) HasErrors() bool {
+	for _, f := range fs {
+		if f.IsError() {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns only the faults with an error severity, or nil if there
+// are none.
+func (fs faultList) Errors() Faults {
+	return fs.filter(func(f *Fault) bool { return f.IsError() })
+}
+
+// Warnings returns the faults with a warning or info severity, or nil if
+// there are none.
+func (fs faultList) Warnings() Faults {
+	return fs.filter(func(f *Fault) bool { return !f.IsError() })
+}
+
+func (fs faultList) filter(keep func(f *Fault) bool) Faults {
+	var out faultList
+	for _, f := range fs {
+		if keep(f) {
+			out = append(out, f)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
 // mergeFaults combines faults that share the same (code, message) pair,
-// concatenating their paths into a single Fault.
+// concatenating their paths into a single Fault. Faults corrected by Fix are
+// kept apart from those that were not.
 func mergeFaults(faults []*Fault) []*Fault {
 	type key struct {
 		code    Code
 		message string
+		fixed   bool
 	}
 	seen := make(map[key]int) // index in result
 	result := make([]*Fault, 0, len(faults))
 	for _, f := range faults {
-		k := key{f.code, f.message}
+		k := key{f.code, f.message, f.fixed}
 		if idx, ok := seen[k]; ok {
 			result[idx].paths = append(result[idx].paths, f.paths...)
 		} else {
 			seen[k] = len(result)
 			result = append(result, &Fault{
-				paths:   append([]string(nil), f.paths...),
-				code:    f.code,
-				message: f.message,
+				paths:    append([]string(nil), f.paths...),
+				code:     f.code,
+				message:  f.message,
+				texts:    f.texts,
+				severity: f.severity,
+				fixable:  f.fixable,
+				fixed:    f.fixed,
 			})
 		}
 	}
@@ -203,9 +299,13 @@ func prependPath(prefix string, faults []*Fault) []*Fault {
 			newPaths[j] = joinPath(prefix, p)
 		}
 		result[i] = &Fault{
-			paths:   newPaths,
-			code:    f.code,
-			message: f.message,
+			paths:    newPaths,
+			code:     f.code,
+			message:  f.message,
+			texts:    f.texts,
+			severity: f.severity,
+			fixable:  f.fixable,
+			fixed:    f.fixed,
 		}
 	}
 	return result
@@ -0,0 +1,205 @@
+package rules
+
+import "reflect"
+
+// Fixer attempts to correct a value that failed an assertion. It receives a
+// pointer to the value that was tested and returns true if it was modified.
+type Fixer func(ptr any) bool
+
+// Autofix returns a Def that assigns the fixer to the assertions created by
+// the wrapped definitions, so that the faults they produce may be corrected
+// automatically with Fix:
+//
+//	rules.For(Unit(""),
+//	    rules.Autofix(fixUnit,
+//	        rules.Assert("01", "unit must be a valid value or UN/ECE code", ...),
+//	    ),
+//	)
+//
+// The fixer is also assigned to assertions defined inside When or
+// AssertIfPresent, as these are evaluated against the same value. Assertions
+// scoped to a field with Field are assigned the fixer too, but it will
+// receive a pointer to the object that Autofix was defined for instead of
+// the field, so that a missing field may be derived from its siblings:
+//
+//	rules.For(new(Identity),
+//	    rules.Autofix(fixIdentityCountry,
+//	        rules.Field("country",
+//	            rules.Assert("01", "tax id country code is always required", is.Present),
+//	        ),
+//	    ),
+//	)
+//
+// Assertions applied to the elements of a slice are never assigned the fixer.
+func Autofix(fix Fixer, defs ...Def) Def {
+	return func(s *Set) {
+		na, ns := len(s.Assert), len(s.Subsets)
+		for _, def := range defs {
+			def(s)
+		}
+		for _, a := range s.Assert[na:] {
+			a.Fix = fix
+		}
+		for _, ss := range s.Subsets[ns:] {
+			setFixer(ss, fix, 0)
+		}
+	}
+}
+
+// setFixer assigns the fixer to the set's assertions, recording the number
+// of fields between the assertion and the object the fixer expects.
+func setFixer(s *Set, fix Fixer, depth int) {
+	if s.Each {
+		return
+	}
+	if s.FieldName != "" {
+		depth++
+	}
+	for _, a := range s.Assert {
+		a.Fix = fix
+		a.fixDepth = depth
+	}
+	for _, ss := range s.Subsets {
+		setFixer(ss, fix, depth)
+	}
+}
+
+// Fix validates the object and applies the fixers of any failed assertions
+// that define one. The object must be a pointer so that changes can be made
+// in place. Returns the faults that were corrected, followed by the faults
+// that remain after validating the object again.
+//
+// Fixes are only applied to values that can be addressed from obj, so values
+// inside maps for example will not be modified.
+func Fix(obj any, opts ...WithContext) (fixed Faults, faults Faults) {
+	rc := newContext(obj, opts...)
+	rc.fix = true
+	var list []*Fault
+	if fs := validateAll(rc, obj); fs != nil {
+		for _, f := range fs.List() {
+			if f.fixed {
+				list = append(list, f)
+			}
+		}
+	}
+	return newFaults(list...), Validate(obj, opts...)
+}
+
+// fixing reports whether fixes should be applied to failed assertions.
+func (c *Context) fixing() bool {
+	return c != nil && c.fix
+}
+
+// pauseFix disables fixing while validating a copy of a value, as any
+// changes would be lost. Returns a function to restore the previous state.
+func (c *Context) pauseFix() func() {
+	if !c.fixing() {
+		return func() {}
+	}
+	c.fix = false
+	return func() { c.fix = true }
+}
+
+// enterField records the parent of a field about to be validated when
+// fixing, so that fixers may be applied to it. Must be followed by a call
+// to leaveField.
+func (c *Context) enterField(parent reflect.Value) {
+	if c.fixing() {
+		c.parents = append(c.parents, parent)
+	}
+}
+
+// leaveField removes the parent recorded with enterField.
+func (c *Context) leaveField() {
+	if c.fixing() {
+		c.parents = c.parents[:len(c.parents)-1]
+	}
+}
+
+// enterTarget records the addressable value about to be validated when
+// fixing, returning the previous target to restore with leaveTarget.
+func (c *Context) enterTarget(v reflect.Value) reflect.Value {
+	if !c.fixing() {
+		return reflect.Value{}
+	}
+	prev := c.target
+	c.target = v
+	return prev
+}
+
+// leaveTarget restores the target replaced with enterTarget.
+func (c *Context) leaveTarget(prev reflect.Value) {
+	if c.fixing() {
+		c.target = prev
+	}
+}
+
+// applyFix calls the assertion's fixer with a pointer to the tested value,
+// and reports whether the assertion's tests pass afterwards.
+func (c *Context) applyFix(a *Assertion, obj any) bool {
+	if a.fixDepth > 0 {
+		return c.applyParentFix(a)
+	}
+	rv := reflect.ValueOf(obj)
+	var ptr reflect.Value
+	switch {
+	case rv.Kind() == reflect.Pointer:
+		if rv.IsNil() {
+			return false
+		}
+		ptr = rv
+	case c.target.IsValid() && c.target.CanAddr() && c.target.Type() == rv.Type():
+		ptr = c.target.Addr()
+	default:
+		return false
+	}
+	if !a.Fix(ptr.Interface()) {
+		return false
+	}
+	// Tests expect the value in the same form as it was originally
+	// provided, except for structs which are always tested by pointer.
+	callObj := ptr.Interface()
+	if rv.Kind() != reflect.Pointer && rv.Kind() != reflect.Struct {
+		callObj = ptr.Elem().Interface()
+	}
+	for _, t := range a.Tests {
+		if !runTest(c, t, callObj) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyParentFix calls the assertion's fixer with a pointer to the object
+// containing the tested field, and reports whether the assertion's tests
+// pass on the field afterwards.
+func (c *Context) applyParentFix(a *Assertion) bool {
+	i := len(c.parents) - a.fixDepth
+	if i < 0 || !c.target.IsValid() {
+		return false
+	}
+	pv := c.parents[i]
+	if !pv.CanAddr() {
+		return false
+	}
+	if !a.Fix(pv.Addr().Interface()) {
+		return false
+	}
+	callObj := c.fieldInterface(c.target)
+	for _, t := range a.Tests {
+		if !runTest(c, t, callObj) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldInterface returns the value as an interface. When fixing, a pointer is
+// used for addressable structs so that nested values may be modified, while
+// plain validation always provides a copy.
+func (c *Context) fieldInterface(fv reflect.Value) any {
+	if c.fixing() && fv.Kind() == reflect.Struct && fv.CanAddr() {
+		return fv.Addr().Interface()
+	}
+	return fv.Interface()
+}
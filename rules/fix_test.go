@@ -0,0 +1,175 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixCode is a named string type that must be upper case, with a fixer.
+type fixCode string
+
+type fixRef struct {
+	Name  string `json:"name"`
+	Label string `json:"label,omitempty"`
+}
+
+type fixDoc struct {
+	Code  fixCode           `json:"code"`
+	Codes []fixCode         `json:"codes,omitempty"`
+	Ref   *fixRef           `json:"ref,omitempty"`
+	Refs  []fixRef          `json:"refs,omitempty"`
+	Map   map[string]fixRef `json:"map,omitempty"`
+}
+
+func fixCodeRules() *rules.Set {
+	return rules.For(fixCode(""),
+		rules.Autofix(fixUpper,
+			rules.Assert("01", "code must be upper case", is.Func("upper", func(v any) bool {
+				c, ok := v.(fixCode)
+				return ok && strings.ToUpper(string(c)) == string(c)
+			})),
+		),
+		rules.Autofix(func(any) bool { return false },
+			rules.Assert("02", "code must not be X", is.Func("not x", func(v any) bool {
+				c, ok := v.(fixCode)
+				return ok && c != "X"
+			})),
+		),
+	)
+}
+
+func fixRefRules() *rules.Set {
+	return rules.For(new(fixRef),
+		rules.Field("name",
+			rules.Autofix(fixTrim,
+				rules.AssertIfPresent("01", "name must not have surrounding spaces",
+					is.Func("trimmed", func(v any) bool {
+						s, ok := v.(string)
+						return ok && strings.TrimSpace(s) == s
+					}),
+				),
+			),
+		),
+		rules.Autofix(fixRefName,
+			rules.Field("name",
+				rules.Assert("02", "name is required", is.Present),
+			),
+		),
+	)
+}
+
+func fixUpper(ptr any) bool {
+	c, ok := ptr.(*fixCode)
+	if !ok {
+		return false
+	}
+	*c = fixCode(strings.ToUpper(string(*c)))
+	return true
+}
+
+func fixRefName(ptr any) bool {
+	r, ok := ptr.(*fixRef)
+	if !ok || r.Label == "" {
+		return false
+	}
+	r.Name = r.Label
+	return true
+}
+
+func fixTrim(ptr any) bool {
+	s, ok := ptr.(*string)
+	if !ok {
+		return false
+	}
+	*s = strings.TrimSpace(*s)
+	return true
+}
+
+func init() {
+	rules.Register("fix-test", rules.GOBL.Add("FIXTEST"), fixCodeRules(), fixRefRules())
+}
+
+func TestFix(t *testing.T) {
+	t.Run("fixable faults", func(t *testing.T) {
+		doc := &fixDoc{Code: "abc"}
+		faults := rules.Validate(doc)
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, rules.Code("GOBL-FIXTEST-FIXCODE-01"), f.Code())
+		assert.True(t, f.Fixable())
+		data, err := json.Marshal(f)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"fixable":true`)
+		assert.Equal(t, fixCode("abc"), doc.Code, "validate must not modify")
+	})
+
+	t.Run("applies fixes", func(t *testing.T) {
+		doc := &fixDoc{
+			Code:  "abc",
+			Codes: []fixCode{"OK", "def"},
+			Ref:   &fixRef{Name: " foo "},
+			Refs:  []fixRef{{Name: "bar "}, {Label: "baz"}},
+		}
+		fixed, faults := rules.Fix(doc)
+		assert.NoError(t, faults)
+		require.Error(t, fixed)
+		assert.Equal(t, fixCode("ABC"), doc.Code)
+		assert.Equal(t, []fixCode{"OK", "DEF"}, doc.Codes)
+		assert.Equal(t, "foo", doc.Ref.Name)
+		assert.Equal(t, "bar", doc.Refs[0].Name)
+		assert.Equal(t, "baz", doc.Refs[1].Name, "fixed from parent")
+		assert.True(t, fixed.HasPath("$.code"))
+		assert.True(t, fixed.HasPath("$.codes[1]"))
+		assert.True(t, fixed.HasPath("$.ref.name"))
+		assert.True(t, fixed.HasPath("$.refs[0].name"))
+		assert.True(t, fixed.HasPath("$.refs[1].name"))
+	})
+
+	t.Run("unfixable faults remain", func(t *testing.T) {
+		doc := &fixDoc{
+			Code: "X",
+			Ref:  &fixRef{},
+			Map:  map[string]fixRef{"a": {Name: " a"}},
+		}
+		fixed, faults := rules.Fix(doc)
+		assert.NoError(t, fixed)
+		require.Error(t, faults)
+		assert.True(t, faults.HasCode("GOBL-FIXTEST-FIXCODE-02"))
+		assert.True(t, faults.HasCode("GOBL-FIXTEST-FIXREF-02"))
+		assert.True(t, faults.HasPath("$.map.a.name"), "map values cannot be addressed")
+	})
+
+	t.Run("no faults", func(t *testing.T) {
+		fixed, faults := rules.Fix(&fixDoc{Code: "OK"})
+		assert.NoError(t, fixed)
+		assert.NoError(t, faults)
+	})
+	t.Run("validation provides copies", func(t *testing.T) {
+		modify := is.Func("modify", func(v any) bool {
+			if r, ok := v.(*fixRef); ok {
+				r.Name = "modified"
+			}
+			return true
+		})
+		set := rules.For(new(fixDoc),
+			rules.Field("refs",
+				rules.Each(rules.Assert("01", "ref is modified", modify)),
+			),
+		)
+		doc := &fixDoc{Code: "OK", Refs: []fixRef{{Name: "a"}}}
+		assert.NoError(t, set.Validate(doc))
+		assert.Equal(t, "a", doc.Refs[0].Name)
+	})
+}
+
+func TestAutofixJSON(t *testing.T) {
+	data, err := json.Marshal(fixCodeRules())
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"fixable":true`)
+}
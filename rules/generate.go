@@ -35,5 +35,23 @@ func generate() error {
 		}
 		fmt.Printf("Processed %v\n", f)
 	}
+	return generateCatalogue()
+}
+
+// generateCatalogue outputs a flat listing of all the registered assertions
+// along with a cross-reference of the business rule codes they implement.
+func generateCatalogue() error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(rules.NewCatalogue()); err != nil {
+		return err
+	}
+	f := filepath.Join("data", "rules", "catalogue.json")
+	if err := os.WriteFile(f, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Processed %v\n", f)
 	return nil
 }
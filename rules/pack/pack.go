@@ -0,0 +1,303 @@
+// Package pack provides declarative rule packs that can be loaded at runtime
+// from JSON or YAML and compiled into rule sets, so that business rules may be
+// added without recompiling GOBL.
+//
+// A rule pack targets a single schema and defines a list of rules, each with
+// a code, a description, an optional field path and an expression to evaluate:
+//
+//	id: ACME
+//	schema: https://gobl.org/draft-0/bill/invoice
+//	guard: 'Customer != nil && Customer.Name == "Customer X"'
+//	rules:
+//	  - code: "01"
+//	    desc: purchase order reference is required
+//	    path: ordering.code
+//	    required: true
+//	  - code: "02"
+//	    desc: item references must not exceed 20 characters
+//	    path: lines[].item.ref
+//	    assert: 'len(this) <= 20'
+//
+// Paths use JSON field names separated by dots, with a "[]" suffix to apply
+// the rule to each element of a slice. Expressions use the syntax of
+// is.Expr, and so must reference Go field names. Expressions that fail to
+// evaluate, for example when reading a field of a nil pointer, are treated
+// as false: assertions will fail and guards will skip their rules.
+package pack
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+
+	"cloud.google.com/go/civil"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/schema"
+	"github.com/invopop/yaml"
+)
+
+// Definition describes a rule pack.
+type Definition struct {
+	// ID is the namespace code prepended to the codes of all the rules in the pack.
+	ID rules.Code `json:"id"`
+	// Schema identifies the type of object the rules will be applied to.
+	Schema schema.ID `json:"schema"`
+	// Guard is an optional expression evaluated against the target object that
+	// must be true for any of the rules to be applied.
+	Guard string `json:"guard,omitempty"`
+	// Rules is the list of rules to apply to the target object.
+	Rules []*Rule `json:"rules"`
+}
+
+// Rule describes a single assertion of a rule pack.
+type Rule struct {
+	// Code identifies the rule within the pack.
+	Code rules.Code `json:"code"`
+	// Desc is the message to include in faults when the rule fails.
+	Desc string `json:"desc"`
+	// I18n provides optional translations of the description.
+	I18n i18n.String `json:"i18n,omitempty"`
+	// Path is the dot-separated list of JSON field names leading to the value
+	// to test. Use a "[]" suffix to test each element of a slice. When empty,
+	// the target object is tested.
+	Path string `json:"path,omitempty"`
+	// When is an optional expression evaluated against the target object that
+	// must be true for the rule to be applied.
+	When string `json:"when,omitempty"`
+	// Required when true expects the value at the path, along with any objects
+	// leading to it, to be present.
+	Required bool `json:"required,omitempty"`
+	// Assert is the expression that must be true for the value at the path.
+	// It is only evaluated when the value is present.
+	Assert string `json:"assert,omitempty"`
+	// Severity of the faults produced by the rule, an error when empty.
+	Severity rules.Severity `json:"severity,omitempty"`
+	// Since is an optional date in "YYYY-MM-DD" format from which the rule applies.
+	Since string `json:"since,omitempty"`
+	// Until is an optional date in "YYYY-MM-DD" format after which the rule no
+	// longer applies.
+	Until string `json:"until,omitempty"`
+}
+
+// Parse decodes a rule pack definition from JSON or YAML data and checks
+// that it is complete.
+func Parse(data []byte) (*Definition, error) {
+	d := new(Definition)
+	if err := yaml.Unmarshal(data, d); err != nil {
+		return nil, fmt.Errorf("parsing rule pack: %w", err)
+	}
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Compile builds a standalone namespace set from the rule pack that can be
+// applied with rules.Validate using the rules.WithSets option.
+func (d *Definition) Compile() (*rules.Set, error) {
+	set, err := d.set()
+	if err != nil {
+		return nil, err
+	}
+	return rules.NewSet(d.ID, set), nil
+}
+
+// Register compiles the rule pack and adds it to the global registry, so that
+// it will be applied by rules.Validate whenever the optional guard passes.
+// Packs should be registered before validation starts, typically on startup.
+func (d *Definition) Register(guard rules.Test) error {
+	set, err := d.set()
+	if err != nil {
+		return err
+	}
+	rules.RegisterWithGuard(strings.ToLower(string(d.ID)), d.ID, guard, set)
+	return nil
+}
+
+// check ensures the definition is complete before compiling.
+func (d *Definition) check() error {
+	if d.ID == "" {
+		return errors.New("rule pack: missing id")
+	}
+	if d.Schema == "" {
+		return fmt.Errorf("rule pack %s: missing schema", d.ID)
+	}
+	if schema.Type(d.Schema) == nil {
+		return fmt.Errorf("rule pack %s: unknown schema: %s", d.ID, d.Schema)
+	}
+	if len(d.Rules) == 0 {
+		return fmt.Errorf("rule pack %s: no rules defined", d.ID)
+	}
+	codes := make(map[rules.Code]bool)
+	for i, r := range d.Rules {
+		if r == nil {
+			return fmt.Errorf("rule pack %s: rule %d is empty", d.ID, i)
+		}
+		if r.Code == "" {
+			return fmt.Errorf("rule pack %s: rule %d: missing code", d.ID, i)
+		}
+		if codes[r.Code] {
+			return fmt.Errorf("rule pack %s: rule %s: duplicate code", d.ID, r.Code)
+		}
+		codes[r.Code] = true
+		if err := r.check(); err != nil {
+			return fmt.Errorf("rule pack %s: rule %s: %w", d.ID, r.Code, err)
+		}
+	}
+	return nil
+}
+
+// set compiles the definition into a set bound to the schema's type. Invalid
+// paths or expressions cause the rules package to panic during compilation,
+// so these are recovered and returned as errors.
+func (d *Definition) set() (set *rules.Set, err error) {
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+	defs := make([]rules.Def, len(d.Rules))
+	for i, r := range d.Rules {
+		defs[i] = r.def()
+	}
+	if d.Guard != "" {
+		defs = []rules.Def{rules.When(expr(d.Guard), defs...)}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			set = nil
+			err = fmt.Errorf("rule pack %s: %v", d.ID, r)
+		}
+	}()
+	obj := reflect.New(schema.Type(d.Schema)).Interface()
+	return rules.For(obj, defs...), nil
+}
+
+func (r *Rule) check() error {
+	if r.Desc == "" && r.I18n[i18n.EN] == "" {
+		return errors.New("missing desc")
+	}
+	if r.Assert == "" && !r.Required {
+		return errors.New("either assert or required must be set")
+	}
+	if r.Required && r.Path == "" {
+		return errors.New("required needs a path")
+	}
+	switch r.Severity {
+	case "", rules.SeverityError, rules.SeverityWarning, rules.SeverityInfo:
+	default:
+		return fmt.Errorf("invalid severity: %s", r.Severity)
+	}
+	for _, date := range []string{r.Since, r.Until} {
+		if date == "" {
+			continue
+		}
+		if _, err := civil.ParseDate(date); err != nil {
+			return fmt.Errorf("invalid date: %s", date)
+		}
+	}
+	if r.Path != "" {
+		for _, seg := range strings.Split(r.Path, ".") {
+			if strings.TrimSuffix(seg, "[]") == "" {
+				return fmt.Errorf("invalid path: %s", r.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// def builds the rule's assertion, wrapped in a field subset for each segment
+// of the path.
+func (r *Rule) def() rules.Def {
+	var tests []rules.Test
+	if r.Required {
+		tests = append(tests, is.Present)
+	}
+	if r.Assert != "" {
+		tests = append(tests, expr(r.Assert))
+	}
+	def := r.assertion(tests...)
+	if !r.Required && r.Path != "" {
+		def = rules.When(is.Present, def)
+	}
+	if r.Path != "" {
+		segs := strings.Split(r.Path, ".")
+		for i := len(segs) - 1; i >= 0; i-- {
+			name, each := strings.CutSuffix(segs[i], "[]")
+			if each {
+				def = rules.Field(name, rules.Each(def))
+				continue
+			}
+			if r.Required && i < len(segs)-1 {
+				// Intermediate objects must be present for the value
+				// to be reached.
+				def = rules.Field(name, r.assertion(is.Present), def)
+				continue
+			}
+			def = rules.Field(name, def)
+		}
+	}
+	if r.When != "" {
+		def = rules.When(expr(r.When), def)
+	}
+	if r.Since != "" {
+		def = rules.Since(r.Since, def)
+	}
+	if r.Until != "" {
+		def = rules.Until(r.Until, def)
+	}
+	return def
+}
+
+func (r *Rule) assertion(tests ...rules.Test) rules.Def {
+	var def rules.Def
+	if len(r.I18n) > 0 {
+		texts := make(i18n.String, len(r.I18n)+1)
+		maps.Copy(texts, r.I18n)
+		if r.Desc != "" {
+			texts[i18n.EN] = r.Desc
+		}
+		def = rules.AssertI18n(r.Code, texts, tests...)
+	} else {
+		def = rules.Assert(r.Code, r.Desc, tests...)
+	}
+	switch r.Severity {
+	case rules.SeverityWarning:
+		def = rules.Warn(def)
+	case rules.SeverityInfo:
+		def = rules.Inform(def)
+	}
+	return def
+}
+
+// safeExpr wraps an expression test so that runtime errors, such as reading
+// a field from a nil pointer, are recovered and treated as a failed check
+// instead of panicking, as packs are loaded from user-supplied data.
+// Assertions will raise a fault, and guards will skip their rules.
+type safeExpr struct {
+	rules.Test
+}
+
+func expr(test string) rules.Test {
+	return &safeExpr{is.Expr("%s", test)}
+}
+
+// Check evaluates the expression, returning false if it fails.
+func (t *safeExpr) Check(val any) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	return t.Test.Check(val)
+}
+
+// Compile prepares the underlying expression.
+func (t *safeExpr) Compile(val any) error {
+	if ct, ok := t.Test.(interface{ Compile(val any) error }); ok {
+		return ct.Compile(val)
+	}
+	return nil
+}
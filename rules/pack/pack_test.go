@@ -0,0 +1,302 @@
+package pack_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/bill"
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/invopop/gobl/rules/pack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPackYAML = `
+id: ACME
+schema: https://gobl.org/draft-0/bill/invoice
+guard: 'Customer != nil && Customer.Name == "Customer X"'
+rules:
+  - code: "01"
+    desc: purchase order reference is required
+    i18n:
+      es: la referencia del pedido es obligatoria
+    path: ordering.code
+    required: true
+  - code: "02"
+    desc: item references must not exceed 5 characters
+    path: lines[].item.ref
+    assert: 'len(this) <= 5'
+  - code: "03"
+    desc: notes are recommended for credit notes
+    when: 'string(Type) == "credit-note"'
+    path: notes
+    required: true
+    severity: warning
+`
+
+const testPackJSON = `{
+	"id": "ACME",
+	"schema": "https://gobl.org/draft-0/bill/invoice",
+	"rules": [
+		{
+			"code": "01",
+			"desc": "invoice series is required",
+			"assert": "Series != \"\""
+		}
+	]
+}`
+
+func testInvoice() *bill.Invoice {
+	return &bill.Invoice{
+		Type:     bill.InvoiceTypeStandard,
+		Customer: &org.Party{Name: "Customer X"},
+		Ordering: &bill.Ordering{Code: "PO-1"},
+		Lines: []*bill.Line{
+			{Item: &org.Item{Name: "Widget", Ref: "W1"}},
+			{Item: &org.Item{Name: "Gadget"}},
+		},
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		d, err := pack.Parse([]byte(testPackYAML))
+		require.NoError(t, err)
+		assert.Equal(t, rules.Code("ACME"), d.ID)
+		assert.Len(t, d.Rules, 3)
+		assert.True(t, d.Rules[0].Required)
+		assert.Equal(t, "la referencia del pedido es obligatoria", d.Rules[0].I18n[i18n.ES])
+		assert.Equal(t, rules.SeverityWarning, d.Rules[2].Severity)
+	})
+	t.Run("json", func(t *testing.T) {
+		d, err := pack.Parse([]byte(testPackJSON))
+		require.NoError(t, err)
+		assert.Equal(t, `Series != ""`, d.Rules[0].Assert)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name string
+			data string
+			err  string
+		}{
+			{"bad syntax", `id: [`, "parsing rule pack"},
+			{"missing id", `schema: https://gobl.org/draft-0/bill/invoice`, "missing id"},
+			{"missing schema", `id: ACME`, "missing schema"},
+			{"unknown schema", "id: ACME\nschema: https://gobl.org/draft-0/foo", "unknown schema"},
+			{"no rules", "id: ACME\nschema: https://gobl.org/draft-0/bill/invoice", "no rules defined"},
+			{
+				"missing code",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - desc: foo\n    assert: 'true'",
+				"rule 0: missing code",
+			},
+			{
+				"duplicate code",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    assert: 'true'\n  - code: '01'\n    desc: bar\n    assert: 'true'",
+				"rule 01: duplicate code",
+			},
+			{
+				"missing desc",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    assert: 'true'",
+				"missing desc",
+			},
+			{
+				"missing english desc",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    i18n:\n      es: foo\n    assert: 'true'",
+				"missing desc",
+			},
+			{
+				"missing test",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo",
+				"either assert or required must be set",
+			},
+			{
+				"required without path",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    required: true",
+				"required needs a path",
+			},
+			{
+				"bad severity",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    assert: 'true'\n    severity: fatal",
+				"invalid severity: fatal",
+			},
+			{
+				"bad path",
+				"id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    path: lines..item\n    assert: 'true'",
+				"invalid path",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := pack.Parse([]byte(tt.data))
+				assert.ErrorContains(t, err, tt.err)
+			})
+		}
+	})
+}
+
+func TestCompile(t *testing.T) {
+	d, err := pack.Parse([]byte(testPackYAML))
+	require.NoError(t, err)
+	set, err := d.Compile()
+	require.NoError(t, err)
+
+	t.Run("passes", func(t *testing.T) {
+		inv := testInvoice()
+		faults := set.Validate(inv)
+		assert.NoError(t, faults)
+	})
+	t.Run("applied with validate option", func(t *testing.T) {
+		inv := testInvoice()
+		inv.Ordering = nil
+		faults := rules.Validate(inv, rules.WithSets(set))
+		require.Error(t, faults)
+		assert.True(t, faults.HasCode("ACME-BILL-INVOICE-01"))
+		assert.False(t, rules.Validate(inv).HasCode("ACME-BILL-INVOICE-01"))
+	})
+	t.Run("required path", func(t *testing.T) {
+		inv := testInvoice()
+		inv.Ordering = nil
+		faults := set.Validate(inv)
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, rules.Code("ACME-BILL-INVOICE-01"), f.Code())
+		assert.Equal(t, []string{"$.ordering"}, f.Paths())
+		assert.Equal(t, "la referencia del pedido es obligatoria", f.MessageIn(i18n.ES))
+
+		inv.Ordering = &bill.Ordering{}
+		faults = set.Validate(inv)
+		require.Error(t, faults)
+		assert.Equal(t, []string{"$.ordering.code"}, faults.First().Paths())
+	})
+	t.Run("each path", func(t *testing.T) {
+		inv := testInvoice()
+		inv.Lines[1].Item.Ref = "GADGET-1"
+		faults := set.Validate(inv)
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, rules.Code("ACME-BILL-INVOICE-02"), f.Code())
+		assert.Equal(t, []string{"$.lines[1].item.ref"}, f.Paths())
+		assert.Equal(t, "item references must not exceed 5 characters", f.Message())
+	})
+	t.Run("guard", func(t *testing.T) {
+		inv := testInvoice()
+		inv.Customer.Name = "Customer Y"
+		inv.Ordering = nil
+		assert.NoError(t, set.Validate(inv))
+	})
+	t.Run("rule condition and severity", func(t *testing.T) {
+		inv := testInvoice()
+		inv.Type = bill.InvoiceTypeCreditNote
+		faults := set.Validate(inv)
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, rules.Code("ACME-BILL-INVOICE-03"), f.Code())
+		assert.Equal(t, rules.SeverityWarning, f.Severity())
+		assert.False(t, faults.HasErrors())
+	})
+	t.Run("nil path in expressions", func(t *testing.T) {
+		d, err := pack.Parse([]byte(`
+id: ACME
+schema: https://gobl.org/draft-0/bill/invoice
+guard: 'Supplier.Name != "Skip"'
+rules:
+  - code: "01"
+    desc: customer name is required
+    assert: 'Customer.Name != ""'
+  - code: "02"
+    desc: notes are required for customer X
+    when: 'Customer.Name == "Customer X"'
+    path: notes
+    required: true
+`))
+		require.NoError(t, err)
+		set, err := d.Compile()
+		require.NoError(t, err)
+
+		inv := testInvoice()
+		inv.Supplier = &org.Party{Name: "Supplier"}
+		inv.Customer = nil
+		var faults rules.Faults
+		require.NotPanics(t, func() {
+			faults = set.Validate(inv)
+		})
+		require.Error(t, faults)
+		assert.True(t, faults.HasCode("ACME-BILL-INVOICE-01"))
+		assert.False(t, faults.HasCode("ACME-BILL-INVOICE-02"), "guard skipped")
+
+		inv.Supplier = nil
+		require.NotPanics(t, func() {
+			faults = rules.Validate(inv, rules.WithSets(set))
+		})
+		assert.False(t, faults.HasCode("ACME-BILL-INVOICE-01"), "pack guard skipped")
+	})
+	t.Run("invalid expression", func(t *testing.T) {
+		d, err := pack.Parse([]byte("id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    assert: 'Unknown == 1'"))
+		require.NoError(t, err)
+		_, err = d.Compile()
+		assert.ErrorContains(t, err, "rule pack ACME: failed to compile assertion BILL-INVOICE-01")
+	})
+	t.Run("unknown field", func(t *testing.T) {
+		d, err := pack.Parse([]byte("id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    path: unknown\n    required: true"))
+		require.NoError(t, err)
+		_, err = d.Compile()
+		assert.ErrorContains(t, err, `field "unknown" not found`)
+	})
+}
+
+func TestEffectiveDates(t *testing.T) {
+	d, err := pack.Parse([]byte(`
+id: ACME
+schema: https://gobl.org/draft-0/bill/invoice
+rules:
+  - code: "01"
+    desc: purchase order reference is required
+    path: ordering.code
+    required: true
+    since: "2026-01-01"
+`))
+	require.NoError(t, err)
+	set, err := d.Compile()
+	require.NoError(t, err)
+
+	inv := testInvoice()
+	inv.Ordering = nil
+	inv.IssueDate = cal.MakeDate(2025, 12, 31)
+	assert.NoError(t, set.Validate(inv))
+	inv.IssueDate = cal.MakeDate(2026, 1, 1)
+	assert.ErrorContains(t, set.Validate(inv), "ACME-BILL-INVOICE-01")
+
+	_, err = pack.Parse([]byte("id: ACME\nschema: https://gobl.org/draft-0/bill/invoice\nrules:\n  - code: '01'\n    desc: foo\n    assert: 'true'\n    until: '2026-02-30'"))
+	assert.ErrorContains(t, err, "invalid date: 2026-02-30")
+}
+
+func TestRegister(t *testing.T) {
+	d, err := pack.Parse([]byte(`
+id: TESTPACK
+schema: https://gobl.org/draft-0/bill/invoice
+rules:
+  - code: "01"
+    desc: invoice series is required
+    path: series
+    required: true
+`))
+	require.NoError(t, err)
+	guard := is.InContext(is.Func("acme tenant", func(v any) bool {
+		tn, ok := v.(tenant)
+		return ok && tn == "acme"
+	}))
+	require.NoError(t, d.Register(guard))
+
+	inv := testInvoice()
+	assert.False(t, rules.Validate(inv).HasCode("TESTPACK-BILL-INVOICE-01"))
+	faults := rules.Validate(inv, func(rc *rules.Context) {
+		rc.Set("tenant", tenant("acme"))
+	})
+	assert.True(t, faults.HasCode("TESTPACK-BILL-INVOICE-01"))
+}
+
+// tenant is used to identify the customer in the validation context.
+type tenant string
@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+
+	"github.com/invopop/gobl/i18n"
 )
 
 // GOBL for GOBL rules.
@@ -184,6 +186,24 @@ func Assert(id Code, desc string, tests ...Test) Def {
 	}
 }
 
+// AssertI18n is similar to Assert, but accepts a description with translations
+// that will be used by Fault.MessageIn. The English text is required, and used
+// as the fault's message, so AssertI18n panics if it is missing.
+func AssertI18n(id Code, desc i18n.String, tests ...Test) Def {
+	if desc[i18n.EN] == "" {
+		panic(fmt.Sprintf("rules: assertion %s requires an English description", id))
+	}
+	a := &Assertion{
+		ID:    id,
+		Desc:  desc[i18n.EN],
+		I18n:  desc,
+		Tests: tests,
+	}
+	return func(s *Set) {
+		s.Assert = append(s.Assert, a)
+	}
+}
+
 // Ignore registers fully-qualified fault codes to suppress from the validation
 // result whenever the enclosing set is active (its guard passes and its type
 // matches). Codes must match exactly — there is no prefix or wildcard matching.
@@ -205,6 +225,16 @@ func WithIgnore(codes ...Code) WithContext {
 	}
 }
 
+// WithSets returns a validation option that applies the provided namespace
+// sets, as created by NewSet, in addition to those in the global registry.
+// Use it to apply rules that are only relevant to a specific call, such as
+// those loaded at runtime from a rule pack.
+func WithSets(sets ...*Set) WithContext {
+	return func(rc *Context) {
+		rc.sets = append(rc.sets, sets...)
+	}
+}
+
 // presentGuard is an internal Test used by AssertIfPresent to skip nil or
 // empty values without creating a dependency on the is package.
 type presentGuard struct{}
@@ -477,11 +507,34 @@ func allSets() []*Set {
 // session. Context is also collected automatically from the root object's
 // exported fields that implement ContextAdder (e.g. tax.Regime, tax.Addons).
 func Validate(obj any, opts ...WithContext) Faults {
+	return validateAll(newContext(obj, opts...), obj)
+}
+
+// ValidateErrors performs the same checks as Validate, but only returns the
+// faults with an error severity, or nil if there are none. Use it wherever
+// the result determines if an object is valid, so that warnings and
+// informational faults never block the caller.
+func ValidateErrors(obj any, opts ...WithContext) Faults {
+	faults := Validate(obj, opts...)
+	if faults == nil {
+		return nil
+	}
+	return faults.Errors()
+}
+
+// newContext prepares the context for a validation session.
+func newContext(obj any, opts ...WithContext) *Context {
 	rc := &Context{}
 	for _, opt := range opts {
 		opt(rc)
 	}
 	collectContext(rc, obj)
+	return rc
+}
+
+// validateAll applies all the registered sets, along with those provided in
+// the context, to the object.
+func validateAll(rc *Context, obj any) Faults {
 	var faults []*Fault
 
 	// Always apply core (unguarded) rule sets.
@@ -513,6 +566,13 @@ func Validate(obj any, opts ...WithContext) Faults {
 		}
 	}
 
+	// Apply any additional sets provided with the WithSets option.
+	for _, ns := range rc.sets {
+		if fs := ns.validate(rc, obj); fs != nil {
+			faults = append(faults, fs.List()...)
+		}
+	}
+
 	// Drop any faults whose code an active set marked for suppression via
 	// rules.Ignore. Applied once over the aggregate so suppression is
 	// independent of the order in which namespaces ran.
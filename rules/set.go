@@ -7,6 +7,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+
+	"cloud.google.com/go/civil"
+	"github.com/invopop/gobl/i18n"
 )
 
 // Set represents a collection of rules grouped by a namespace
@@ -30,6 +33,10 @@ type Set struct {
 	Subsets []*Set `json:"subsets,omitempty"`
 	// Ignore lists fully-qualified fault codes to suppress from the validation result whenever this set is active (its guard passes and its type matches). Codes are foreign (emitted by other namespaces) and are therefore NOT namespace-prefixed at registration.
 	Ignore []Code `json:"ignore,omitempty"`
+	// Since when set defines the date from which the set applies, compared with the date in the validation context.
+	Since *civil.Date `json:"since,omitempty"`
+	// Until when set defines the last date on which the set applies, compared with the date in the validation context.
+	Until *civil.Date `json:"until,omitempty"`
 
 	objType   reflect.Type
 	typeIndex map[reflect.Type][]*Set // maps objType → subsets targeting that type
@@ -41,8 +48,20 @@ type Assertion struct {
 	ID Code `json:"id"`
 	// Desc is the human-readable message to include in faults when this assertion fails.
 	Desc string `json:"desc,omitempty"`
+	// I18n provides optional translations of the description, keyed by language.
+	I18n i18n.String `json:"i18n,omitempty"`
 	// Tests is a list of tests to evaluate for this assertion. A false result indicates a failure.
 	Tests []Test `json:"tests"`
+	// Severity of the faults produced by this assertion, an error when empty.
+	Severity Severity `json:"severity,omitempty"`
+	// Since when set defines the date from which the assertion applies.
+	Since *civil.Date `json:"since,omitempty"`
+	// Until when set defines the last date on which the assertion applies.
+	Until *civil.Date `json:"until,omitempty"`
+	// Fix is an optional function used by Fix to correct values that fail the assertion.
+	Fix Fixer `json:"-"`
+
+	fixDepth int // fields between the assertion and the value to fix
 }
 
 // buildTypeIndex populates the typeIndex map on a namespace set by grouping
@@ -168,11 +187,23 @@ func (s *Set) validate(rc *Context, obj any) Faults {
 			ptr := reflect.New(rv.Type())
 			ptr.Elem().Set(rv)
 			callObj = ptr.Interface()
+			defer rc.pauseFix()()
 		}
 	}
 
+	if rc.enterSet(s) {
+		defer rc.leaveSet()
+	}
+
+	// Skip sets that are not effective on the date in the context.
+	if !effectiveOn(rc, s.Since, s.Until) {
+		rc.traceSet(s, TraceInactive, nil)
+		return nil
+	}
+
 	// Evaluate the When condition; skip the set if it doesn't match.
 	if s.Guard != nil && !runTest(rc, s.Guard, callObj) {
+		rc.traceSet(s, TraceSkipped, nil)
 		return nil
 	}
 
@@ -190,13 +221,26 @@ func (s *Set) validate(rc *Context, obj any) Faults {
 		if len(a.Tests) == 0 {
 			panic(fmt.Sprintf("assertion %s (%q) tests missing", a.ID, a.Tests))
 		}
+		if !effectiveOn(rc, a.Since, a.Until) {
+			continue
+		}
 		for _, t := range a.Tests {
 			if !runTest(rc, t, callObj) {
-				faults = append(faults, newFault("", a.ID, a.Desc))
+				f := newFault("", a.ID, a.Desc, a.Severity).withTexts(a.I18n)
+				f.fixable = a.Fix != nil
+				if f.fixable && rc.fixing() {
+					f.fixed = rc.applyFix(a, obj)
+				}
+				faults = append(faults, f)
 				break
 			}
 		}
 	}
+	if s.Guard != nil {
+		rc.traceSet(s, TracePassed, faults)
+	} else {
+		rc.traceSet(s, TraceApplied, faults)
+	}
 
 	// Process subsets and nested fields when the object is not nil.
 	if !isNil {
@@ -225,7 +269,14 @@ func (s *Set) validateSubsets(rc *Context, rv reflect.Value, obj, callObj any) [
 			if !ok {
 				continue
 			}
-			if fs := ss.validate(rc, fv.Interface()); fs != nil {
+			rc.enterPath(ss.FieldName)
+			rc.enterField(rv)
+			prev := rc.enterTarget(fv)
+			fs := ss.validate(rc, rc.fieldInterface(fv))
+			rc.leaveTarget(prev)
+			rc.leaveField()
+			rc.leavePath()
+			if fs != nil {
 				faults = append(faults, prependPath(ss.FieldName, fs.List())...)
 			}
 		}
@@ -247,7 +298,13 @@ func (s *Set) validateSubsets(rc *Context, rv reflect.Value, obj, callObj any) [
 					continue
 				}
 				fv := rv.Field(i)
+				name := ""
+				if !sf.Anonymous {
+					name = jsonFieldName(sf)
+				}
+				rc.enterPath(name)
 				fs := s.validateNestedFieldValue(rc, fv)
+				rc.leavePath()
 				if len(fs) == 0 {
 					continue
 				}
@@ -255,16 +312,18 @@ func (s *Set) validateSubsets(rc *Context, rv reflect.Value, obj, callObj any) [
 					faults = append(faults, fs...)
 					continue
 				}
-				name := jsonFieldName(sf)
 				if name != "" {
 					faults = append(faults, prependPath(name, fs)...)
 				}
 			}
 		case reflect.Slice, reflect.Array:
 			for i := range rv.Len() {
-				ev := rv.Index(i)
-				if fs := s.validateNestedFieldValue(rc, ev); len(fs) > 0 {
-					faults = append(faults, prependPath("["+strconv.Itoa(i)+"]", fs)...)
+				idx := "[" + strconv.Itoa(i) + "]"
+				rc.enterPath(idx)
+				fs := s.validateNestedFieldValue(rc, rv.Index(i))
+				rc.leavePath()
+				if len(fs) > 0 {
+					faults = append(faults, prependPath(idx, fs)...)
 				}
 			}
 		}
@@ -312,6 +371,7 @@ func (s *Set) validateNestedValue(rc *Context, obj any) []*Fault {
 		ptr := reflect.New(rv.Type())
 		ptr.Elem().Set(rv)
 		callObj = ptr.Interface()
+		defer rc.pauseFix()()
 	}
 
 	var faults []*Fault
@@ -332,7 +392,13 @@ func (s *Set) validateNestedValue(rc *Context, obj any) []*Fault {
 				continue
 			}
 			fv := rv.Field(i)
+			name := ""
+			if !sf.Anonymous {
+				name = jsonFieldName(sf)
+			}
+			rc.enterPath(name)
 			fs := s.validateNestedFieldValue(rc, fv)
+			rc.leavePath()
 			if len(fs) == 0 {
 				continue
 			}
@@ -340,7 +406,6 @@ func (s *Set) validateNestedValue(rc *Context, obj any) []*Fault {
 				faults = append(faults, fs...)
 				continue
 			}
-			name := jsonFieldName(sf)
 			if name != "" {
 				faults = append(faults, prependPath(name, fs)...)
 			}
@@ -368,18 +433,23 @@ func (s *Set) validateNestedFieldValue(rc *Context, fv reflect.Value) []*Fault {
 	}
 	switch fv.Kind() {
 	case reflect.Struct:
-		return s.validateNestedValue(rc, fv.Interface())
+		return s.validateNestedValue(rc, rc.fieldInterface(fv))
 	case reflect.Slice, reflect.Array:
 		var faults []*Fault
 		// For named slice types (e.g. tax.Set), apply type-specific rules to
 		// the whole slice before iterating its elements.
 		if fv.Type().PkgPath() != "" {
+			prev := rc.enterTarget(fv)
 			faults = append(faults, s.validateNestedValue(rc, fv.Interface())...)
+			rc.leaveTarget(prev)
 		}
 		for i := range fv.Len() {
-			ev := fv.Index(i)
-			if fs := s.validateNestedFieldValue(rc, ev); len(fs) > 0 {
-				faults = append(faults, prependPath("["+strconv.Itoa(i)+"]", fs)...)
+			idx := "[" + strconv.Itoa(i) + "]"
+			rc.enterPath(idx)
+			fs := s.validateNestedFieldValue(rc, fv.Index(i))
+			rc.leavePath()
+			if len(fs) > 0 {
+				faults = append(faults, prependPath(idx, fs)...)
 			}
 		}
 		return faults
@@ -401,6 +471,7 @@ func (s *Set) validateNestedFieldValue(rc *Context, fv reflect.Value) []*Fault {
 		var faults []*Fault
 		for _, ks := range sorted {
 			k := keyByStr[ks]
+			rc.enterPath(ks)
 			// Validate named key types (e.g. cbc.Key).
 			if k.Type().PkgPath() != "" {
 				if fs := s.validateNestedValue(rc, k.Interface()); len(fs) > 0 {
@@ -412,11 +483,14 @@ func (s *Set) validateNestedFieldValue(rc *Context, fv reflect.Value) []*Fault {
 			if fs := s.validateNestedFieldValue(rc, ev); len(fs) > 0 {
 				faults = append(faults, prependPath(ks, fs)...)
 			}
+			rc.leavePath()
 		}
 		return faults
 	default:
 		// For named non-struct types (e.g. cbc.Code), check this namespace's rules.
 		if fv.Type().PkgPath() != "" {
+			prev := rc.enterTarget(fv)
+			defer rc.leaveTarget(prev)
 			return s.validateNestedValue(rc, fv.Interface())
 		}
 	}
@@ -438,9 +512,14 @@ func validateEachValue(rc *Context, fv reflect.Value, ss *Set) []*Fault {
 	}
 	var faults []*Fault
 	for i := range fv.Len() {
-		ev := fv.Index(i)
-		if fs := ss.validate(rc, ev.Interface()); fs != nil {
-			faults = append(faults, prependPath("["+strconv.Itoa(i)+"]", fs.List())...)
+		idx := "[" + strconv.Itoa(i) + "]"
+		rc.enterPath(idx)
+		prev := rc.enterTarget(fv.Index(i))
+		fs := ss.validate(rc, rc.fieldInterface(fv.Index(i)))
+		rc.leaveTarget(prev)
+		rc.leavePath()
+		if fs != nil {
+			faults = append(faults, prependPath(idx, fs.List())...)
 		}
 	}
 	return faults
@@ -455,6 +534,8 @@ func (s Set) MarshalJSON() ([]byte, error) {
 		FieldName string       `json:"field,omitempty"`
 		Each      bool         `json:"each,omitempty"`
 		Guard     string       `json:"guard,omitempty"`
+		Since     *civil.Date  `json:"since,omitempty"`
+		Until     *civil.Date  `json:"until,omitempty"`
 		Assert    []*Assertion `json:"assert,omitempty"`
 		Subsets   []*Set       `json:"subsets,omitempty"`
 	}
@@ -464,6 +545,8 @@ func (s Set) MarshalJSON() ([]byte, error) {
 		Object:    s.Object,
 		FieldName: s.FieldName,
 		Each:      s.Each,
+		Since:     s.Since,
+		Until:     s.Until,
 		Assert:    s.Assert,
 		Subsets:   s.Subsets,
 	}
@@ -476,17 +559,27 @@ func (s Set) MarshalJSON() ([]byte, error) {
 // MarshalJSON serializes Assertion to JSON, converting Tests to a comma-joined string.
 func (a Assertion) MarshalJSON() ([]byte, error) {
 	type alias struct {
-		ID    Code   `json:"id"`
-		Desc  string `json:"desc,omitempty"`
-		Tests string `json:"tests,omitempty"`
+		ID       Code        `json:"id"`
+		Desc     string      `json:"desc,omitempty"`
+		I18n     i18n.String `json:"i18n,omitempty"`
+		Tests    string      `json:"tests,omitempty"`
+		Severity Severity    `json:"severity,omitempty"`
+		Since    *civil.Date `json:"since,omitempty"`
+		Until    *civil.Date `json:"until,omitempty"`
+		Fixable  bool        `json:"fixable,omitempty"`
 	}
 	parts := make([]string, len(a.Tests))
 	for i, t := range a.Tests {
 		parts[i] = t.String()
 	}
 	return json.Marshal(alias{
-		ID:    a.ID,
-		Desc:  a.Desc,
-		Tests: strings.Join(parts, ", "),
+		ID:       a.ID,
+		Desc:     a.Desc,
+		I18n:     a.I18n,
+		Tests:    strings.Join(parts, ", "),
+		Severity: a.Severity,
+		Since:    a.Since,
+		Until:    a.Until,
+		Fixable:  a.Fix != nil,
 	})
 }
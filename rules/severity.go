@@ -0,0 +1,65 @@
+package rules
+
+// Severity indicates how serious a fault is. Only faults with an error
+// severity make an object invalid; warnings and informational faults are
+// reported alongside them so that callers may decide how to act.
+type Severity string
+
+// Severity levels that may be assigned to assertions.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Warn returns a Def that applies the wrapped definitions with a warning
+// severity, so that any faults they produce do not make the object invalid.
+// All assertions defined inside, including those nested in Field, Each or
+// When, are affected:
+//
+//	rules.Warn(
+//	    rules.Field("notes",
+//	        rules.Assert("05", "no more than one note expected", is.Length(0, 1)),
+//	    ),
+//	)
+func Warn(defs ...Def) Def {
+	return withSeverity(SeverityWarning, defs...)
+}
+
+// Inform returns a Def that applies the wrapped definitions with an info
+// severity, used for faults that only provide guidance.
+func Inform(defs ...Def) Def {
+	return withSeverity(SeverityInfo, defs...)
+}
+
+func withSeverity(sev Severity, defs ...Def) Def {
+	return func(s *Set) {
+		na, ns := len(s.Assert), len(s.Subsets)
+		for _, def := range defs {
+			def(s)
+		}
+		for _, a := range s.Assert[na:] {
+			a.Severity = sev
+		}
+		for _, ss := range s.Subsets[ns:] {
+			setSeverity(ss, sev)
+		}
+	}
+}
+
+func setSeverity(s *Set, sev Severity) {
+	for _, a := range s.Assert {
+		a.Severity = sev
+	}
+	for _, ss := range s.Subsets {
+		setSeverity(ss, sev)
+	}
+}
+
+// level returns the severity, defaulting to an error when not set.
+func (sev Severity) level() Severity {
+	if sev == "" {
+		return SeverityError
+	}
+	return sev
+}
@@ -0,0 +1,104 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func severityRules() *rules.Set {
+	return rules.For(new(Person),
+		rules.Field("name",
+			rules.Assert("01", "name is required", is.Present),
+		),
+		rules.Warn(
+			rules.Field("address",
+				rules.Assert("02", "address is recommended", is.Present),
+			),
+			rules.Assert("03", "age is recommended", is.Expr(`Age > 0`)),
+		),
+		rules.Inform(
+			rules.Field("emails",
+				rules.Assert("04", "emails help with delivery", is.Present),
+			),
+		),
+	)
+}
+
+func TestSeverity(t *testing.T) {
+	set := severityRules()
+
+	t.Run("defaults to error", func(t *testing.T) {
+		faults := set.Validate(&Person{Age: 30, Address: &Address{}, Emails: []Email{{Addr: "a@b.com"}}})
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, rules.SeverityError, f.Severity())
+		assert.True(t, f.IsError())
+		assert.True(t, faults.HasErrors())
+		assert.Nil(t, faults.Warnings())
+	})
+
+	t.Run("warnings and info", func(t *testing.T) {
+		faults := set.Validate(&Person{Name: "Sam"})
+		require.Error(t, faults)
+		assert.False(t, faults.HasErrors())
+		assert.Nil(t, faults.Errors())
+		require.Equal(t, 3, faults.Len())
+		assert.Equal(t, rules.SeverityWarning, faults.At(0).Severity())
+		assert.Equal(t, rules.SeverityWarning, faults.At(1).Severity())
+		assert.Equal(t, rules.SeverityInfo, faults.At(2).Severity())
+		assert.False(t, faults.At(0).IsError())
+		assert.Equal(t, 3, faults.Warnings().Len())
+	})
+
+	t.Run("mixed", func(t *testing.T) {
+		faults := set.Validate(&Person{Age: 30})
+		require.Error(t, faults)
+		assert.True(t, faults.HasErrors())
+		assert.Equal(t, 1, faults.Errors().Len())
+		assert.True(t, faults.Errors().HasCode("PERSON-01"))
+		assert.Equal(t, 2, faults.Warnings().Len())
+		assert.True(t, faults.Warnings().HasCode("PERSON-02"))
+		assert.True(t, faults.Warnings().HasCode("PERSON-04"))
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		faults := set.Validate(&Person{Name: "Sam", Age: 30, Emails: []Email{{Addr: "a@b.com"}}})
+		require.Error(t, faults)
+		data, err := json.Marshal(faults.First())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"code":"PERSON-02","paths":["$.address"],"message":"address is recommended","severity":"warning"}`, string(data))
+	})
+
+	t.Run("assertion definitions", func(t *testing.T) {
+		data, err := json.Marshal(set)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"id":"PERSON-02","desc":"address is recommended","tests":"present","severity":"warning"`)
+		assert.Contains(t, string(data), `"severity":"info"`)
+		assert.NotContains(t, string(data), `"severity":"error"`)
+	})
+}
+
+func TestValidateErrors(t *testing.T) {
+	opt := rules.WithSets(severityRules())
+
+	faults := rules.Validate(&Person{Name: "Sam"}, opt)
+	require.Error(t, faults)
+	assert.True(t, faults.HasCode("PERSON-02"))
+	faults = rules.ValidateErrors(&Person{Name: "Sam"}, opt)
+	if faults != nil {
+		// other rules registered for the type may still raise errors
+		assert.False(t, faults.HasCode("PERSON-02"))
+		assert.Nil(t, faults.Warnings())
+	}
+
+	faults = rules.ValidateErrors(&Person{Age: 30}, opt)
+	require.Error(t, faults)
+	assert.True(t, faults.HasCode("PERSON-01"))
+	assert.False(t, faults.HasCode("PERSON-02"))
+	assert.Nil(t, faults.Warnings())
+}
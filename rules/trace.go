@@ -0,0 +1,155 @@
+package rules
+
+import "encoding/json"
+
+// TraceStatus describes the outcome of evaluating a set during validation.
+type TraceStatus string
+
+// Trace statuses recorded for each set evaluated.
+const (
+	// TraceApplied is used for sets without a guard.
+	TraceApplied TraceStatus = "applied"
+	// TracePassed is used for sets whose guard passed.
+	TracePassed TraceStatus = "passed"
+	// TraceSkipped is used for sets whose guard failed, so none of their
+	// assertions or subsets were evaluated.
+	TraceSkipped TraceStatus = "skipped"
+	// TraceInactive is used for sets that are not effective on the date
+	// in the validation context.
+	TraceInactive TraceStatus = "inactive"
+)
+
+// Trace records how rule sets were evaluated during a validation session to
+// help understand why a rule did, or did not, apply. Prepare a new trace with
+// NewTrace and pass it to Validate using the WithTrace option.
+type Trace struct {
+	paths []*TracePath
+	index map[string]*TracePath
+}
+
+// TracePath groups the sets evaluated against the object at a given path.
+type TracePath struct {
+	// Path is the JSONPath-style location of the object, such as
+	// `$.lines[0].item`, not a JSON Pointer.
+	Path string `json:"path"`
+	// Sets lists the sets evaluated, in order.
+	Sets []*TraceSet `json:"sets"`
+}
+
+// TraceSet describes the evaluation of a single set.
+type TraceSet struct {
+	// ID of the set, or of the closest parent set with an ID for anonymous
+	// subsets such as those defined with When.
+	ID Code `json:"id,omitempty"`
+	// Guard is the string representation of the set's guard, if any.
+	Guard string `json:"guard,omitempty"`
+	// Status is the outcome of evaluating the set.
+	Status TraceStatus `json:"status"`
+	// Faults lists the codes of the set's assertions that failed. Faults
+	// later suppressed with Ignore are included.
+	Faults []Code `json:"faults,omitempty"`
+}
+
+// NewTrace prepares a new empty trace.
+func NewTrace() *Trace {
+	return &Trace{index: make(map[string]*TracePath)}
+}
+
+// WithTrace returns a validation option that records the evaluation of
+// rule sets in the provided trace.
+func WithTrace(t *Trace) WithContext {
+	return func(rc *Context) {
+		rc.trace = t
+	}
+}
+
+// Paths returns the paths recorded in the trace, in the order they were
+// first visited.
+func (t *Trace) Paths() []*TracePath {
+	return t.paths
+}
+
+// Path returns the trace for the object at the given JSON path, or nil.
+func (t *Trace) Path(path string) *TracePath {
+	return t.index[path]
+}
+
+// MarshalJSON outputs the trace as an array of paths.
+func (t *Trace) MarshalJSON() ([]byte, error) {
+	if t.paths == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t.paths)
+}
+
+func (t *Trace) add(path string, ts *TraceSet) {
+	tp, ok := t.index[path]
+	if !ok {
+		tp = &TracePath{Path: path}
+		t.index[path] = tp
+		t.paths = append(t.paths, tp)
+	}
+	tp.Sets = append(tp.Sets, ts)
+}
+
+// tracing reports whether a trace is being recorded.
+func (c *Context) tracing() bool {
+	return c != nil && c.trace != nil
+}
+
+// enterPath adds a path segment when tracing, to be removed with leavePath.
+func (c *Context) enterPath(seg string) {
+	if c.tracing() {
+		c.path = append(c.path, seg)
+	}
+}
+
+// leavePath removes the last path segment added with enterPath.
+func (c *Context) leavePath() {
+	if c.tracing() {
+		c.path = c.path[:len(c.path)-1]
+	}
+}
+
+// enterSet records the ID of the set being evaluated so that anonymous
+// subsets can be identified by their parent. Returns true if the ID was
+// added and leaveSet must be called.
+func (c *Context) enterSet(s *Set) bool {
+	if !c.tracing() || s.ID == "" {
+		return false
+	}
+	c.setIDs = append(c.setIDs, s.ID)
+	return true
+}
+
+// leaveSet removes the set ID added with enterSet.
+func (c *Context) leaveSet() {
+	c.setIDs = c.setIDs[:len(c.setIDs)-1]
+}
+
+// traceSet records the evaluation of a set at the current path. Anonymous
+// sets without a guard or effective dates are only recorded when they
+// produce faults.
+func (c *Context) traceSet(s *Set, status TraceStatus, faults []*Fault) {
+	if !c.tracing() {
+		return
+	}
+	if s.ID == "" && s.Guard == nil && status != TraceInactive && len(faults) == 0 {
+		return
+	}
+	ts := &TraceSet{Status: status}
+	if n := len(c.setIDs); n > 0 {
+		ts.ID = c.setIDs[n-1]
+	}
+	if s.Guard != nil {
+		ts.Guard = s.Guard.String()
+	}
+	for _, f := range faults {
+		ts.Faults = append(ts.Faults, f.code)
+	}
+	var path string
+	for _, seg := range c.path {
+		path = joinPath(path, seg)
+	}
+	c.trace.add(publicPath(path), ts)
+}
@@ -0,0 +1,113 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func traceRules() *rules.Set {
+	return rules.NewSet("TRACE",
+		rules.For(new(Person),
+			rules.Field("name",
+				rules.Assert("01", "name is required", is.Present),
+			),
+			rules.When(is.Expr(`Age >= 18`),
+				rules.Field("address",
+					rules.Assert("02", "adults require an address", is.Present),
+				),
+			),
+			rules.When(is.Expr(`Age < 18`),
+				rules.Assert("03", "minors are not accepted", is.Expr(`false`)),
+			),
+		),
+		rules.For(new(Email),
+			rules.Field("addr",
+				rules.Assert("01", "email address is required", is.Present),
+			),
+		),
+	)
+}
+
+func TestTrace(t *testing.T) {
+	set := traceRules()
+
+	t.Run("records sets, guards and faults", func(t *testing.T) {
+		tr := rules.NewTrace()
+		faults := set.Validate(&Person{Age: 30, Emails: []Email{{Addr: "a@b.com"}, {}}}, rules.WithTrace(tr))
+		require.Error(t, faults)
+
+		root := tr.Path("$")
+		require.NotNil(t, root)
+		require.Len(t, root.Sets, 4)
+		assert.Equal(t, rules.Code("TRACE"), root.Sets[0].ID)
+		assert.Equal(t, rules.TraceApplied, root.Sets[0].Status)
+		assert.Equal(t, rules.Code("TRACE-PERSON"), root.Sets[1].ID)
+		assert.Equal(t, rules.TraceApplied, root.Sets[1].Status)
+		assert.Equal(t, rules.Code("TRACE-PERSON"), root.Sets[2].ID)
+		assert.Equal(t, "Age >= 18", root.Sets[2].Guard)
+		assert.Equal(t, rules.TracePassed, root.Sets[2].Status)
+		assert.Equal(t, "Age < 18", root.Sets[3].Guard)
+		assert.Equal(t, rules.TraceSkipped, root.Sets[3].Status)
+
+		name := tr.Path("$.name")
+		require.NotNil(t, name)
+		require.Len(t, name.Sets, 1)
+		assert.Equal(t, rules.Code("TRACE-PERSON"), name.Sets[0].ID)
+		assert.Equal(t, []rules.Code{"TRACE-PERSON-01"}, name.Sets[0].Faults)
+
+		addr := tr.Path("$.address")
+		require.NotNil(t, addr)
+		assert.Equal(t, []rules.Code{"TRACE-PERSON-02"}, addr.Sets[0].Faults)
+
+		email := tr.Path("$.emails[0]")
+		require.NotNil(t, email)
+		assert.Equal(t, rules.Code("TRACE-EMAIL"), email.Sets[0].ID)
+		assert.Empty(t, email.Sets[0].Faults)
+		assert.Nil(t, tr.Path("$.emails[0].addr"))
+
+		email = tr.Path("$.emails[1].addr")
+		require.NotNil(t, email)
+		assert.Equal(t, []rules.Code{"TRACE-EMAIL-01"}, email.Sets[0].Faults)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		tr := rules.NewTrace()
+		set.Validate(&Person{Name: "Sam", Age: 10}, rules.WithTrace(tr))
+		data, err := json.Marshal(tr)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"path": "$", "sets": [
+				{"id": "TRACE", "status": "applied"},
+				{"id": "TRACE-PERSON", "status": "applied"},
+				{"id": "TRACE-PERSON", "guard": "Age >= 18", "status": "skipped"},
+				{"id": "TRACE-PERSON", "guard": "Age < 18", "status": "passed", "faults": ["TRACE-PERSON-03"]}
+			]}
+		]`, string(data))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, err := json.Marshal(rules.NewTrace())
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, string(data))
+	})
+
+	t.Run("global registry", func(t *testing.T) {
+		tr := rules.NewTrace()
+		rules.Validate(TestCode(""), rules.WithTrace(tr))
+		root := tr.Path("$")
+		require.NotNil(t, root)
+		var found bool
+		for _, ts := range root.Sets {
+			if len(ts.Faults) > 0 {
+				found = true
+				assert.Contains(t, ts.Faults, rules.Code("GOBL-TEST-TESTCODE-01"))
+			}
+		}
+		assert.True(t, found)
+	})
+}
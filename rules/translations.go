@@ -0,0 +1,31 @@
+package rules
+
+import "github.com/invopop/gobl/i18n"
+
+// Translations maps fully-qualified fault codes to their translated messages.
+type Translations map[Code]i18n.String
+
+// translations holds the global catalogue of translated fault messages.
+var translations = make(Translations)
+
+// RegisterTranslations adds fault message translations to the global catalogue
+// so that regimes and addons can provide messages in other languages without
+// modifying rule definitions. Codes must be fully qualified, as they appear in
+// faults. Languages already registered for a code are replaced.
+func RegisterTranslations(t Translations) {
+	for code, texts := range t {
+		if translations[code] == nil {
+			translations[code] = make(i18n.String)
+		}
+		for lang, msg := range texts {
+			translations[code][lang] = msg
+		}
+	}
+}
+
+// Translation returns the message registered in the catalogue for the fault
+// code in the given language, if available.
+func Translation(code Code, lang i18n.Lang) (string, bool) {
+	msg, ok := translations[code][lang]
+	return msg, ok
+}
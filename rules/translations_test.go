@@ -0,0 +1,101 @@
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func translatedRules() *rules.Set {
+	return rules.For(new(Address),
+		rules.Field("street",
+			rules.AssertI18n("01", i18n.String{
+				"en": "street is required",
+				"es": "la calle es obligatoria",
+			}, is.Present),
+		),
+		rules.Field("city",
+			rules.Assert("02", "city is required", is.Present),
+		),
+	)
+}
+
+func TestFaultMessageIn(t *testing.T) {
+	set := translatedRules()
+	rules.RegisterTranslations(rules.Translations{
+		"ADDRESS-01": {
+			"es": "overridden by the assertion",
+			"it": "la via è obbligatoria",
+		},
+		"ADDRESS-02": {
+			"es": "la ciudad es obligatoria",
+		},
+	})
+	rules.RegisterTranslations(rules.Translations{
+		"ADDRESS-02": {
+			"pt": "a cidade é obrigatória",
+		},
+	})
+
+	faults := set.Validate(&Address{})
+	require.Equal(t, 2, faults.Len())
+
+	t.Run("assertion translations", func(t *testing.T) {
+		f := faults.At(0)
+		assert.Equal(t, "street is required", f.Message())
+		assert.Equal(t, "street is required", f.MessageIn(i18n.EN))
+		assert.Equal(t, "la calle es obligatoria", f.MessageIn(i18n.ES))
+		assert.Equal(t, "la via è obbligatoria", f.MessageIn("it"))
+		assert.Equal(t, "street is required", f.MessageIn("de"))
+	})
+
+	t.Run("catalogue translations", func(t *testing.T) {
+		f := faults.At(1)
+		assert.Equal(t, "city is required", f.MessageIn(i18n.EN))
+		assert.Equal(t, "la ciudad es obligatoria", f.MessageIn(i18n.ES))
+		assert.Equal(t, "a cidade é obrigatória", f.MessageIn("pt"))
+		assert.Equal(t, "city is required", f.MessageIn("de"))
+	})
+
+	t.Run("nested paths", func(t *testing.T) {
+		ns := rules.NewSet("TEST", translatedRules())
+		faults := ns.Validate(&Person{Address: &Address{City: "Madrid"}})
+		require.Error(t, faults)
+		f := faults.First()
+		assert.Equal(t, []string{"$.address.street"}, f.Paths())
+		assert.Equal(t, "la calle es obligatoria", f.MessageIn(i18n.ES))
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		msg, ok := rules.Translation("ADDRESS-02", "es")
+		assert.True(t, ok)
+		assert.Equal(t, "la ciudad es obligatoria", msg)
+		_, ok = rules.Translation("ADDRESS-02", "fr")
+		assert.False(t, ok)
+		_, ok = rules.Translation("UNKNOWN-01", "es")
+		assert.False(t, ok)
+	})
+
+	t.Run("assertion json", func(t *testing.T) {
+		data, err := json.Marshal(set)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"desc":"street is required","i18n":{"en":"street is required","es":"la calle es obligatoria"}`)
+	})
+}
+
+func TestAssertI18nRequiresEnglish(t *testing.T) {
+	assert.PanicsWithValue(t, "rules: assertion 01 requires an English description", func() {
+		rules.For(new(Address),
+			rules.Field("street",
+				rules.AssertI18n("01", i18n.String{
+					"es": "la calle es obligatoria",
+				}, is.Present),
+			),
+		)
+	})
+}
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
+)
+
+// The i18n package cannot depend on the rules package, as it is used to
+// translate fault messages, so its rules are defined and registered here.
+func langRules() *rules.Set {
+	return rules.For(i18n.Lang(""),
+		rules.Assert("01", "must be a valid language code", is.In(validLangValues()...)),
+	)
+}
+
+func validLangValues() []any {
+	list := make([]any, len(i18n.LangDefinitions))
+	for i, d := range i18n.LangDefinitions {
+		list[i] = string(d.Code)
+	}
+	return list
+}
@@ -0,0 +1,18 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestI18nRegistration(t *testing.T) {
+	assert.Equal(t, schema.GOBL.Add("i18n/string"), schema.Lookup(i18n.String{}))
+
+	assert.NoError(t, rules.Validate(i18n.Lang("es")))
+	err := rules.Validate(i18n.Lang("xx"))
+	assert.ErrorContains(t, err, "[GOBL-I18N-LANG-01] must be a valid language code")
+}
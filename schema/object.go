@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/pkg/here"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/rules/is"
@@ -43,6 +44,12 @@ type Calculable interface {
 	Calculate() error
 }
 
+// Recordable defines the methods expected of a document payload that can
+// record the changes made to it during calculation.
+type Recordable interface {
+	CalculateWithRecorder(rec *norm.Recorder) error
+}
+
 // Correctable defines the expected interface of a document that can be
 // corrected.
 type Correctable interface {
@@ -79,8 +86,20 @@ func objectRules() *rules.Set {
 // Validate will check the document payload for any rule violations
 // and return them as a list of faults. This will only check the
 // payload of the object, which would not otherwise be verified.
+// Faults with a warning or info severity are not included, use
+// ValidateWithWarnings to retrieve them.
 func (d *Object) Validate() rules.Faults {
-	return rules.Validate(d.Instance())
+	return rules.ValidateErrors(d.Instance())
+}
+
+// ValidateWithWarnings performs the same checks as Validate, and additionally
+// returns any faults with a warning or info severity.
+func (d *Object) ValidateWithWarnings() (warnings rules.Faults, errs rules.Faults) {
+	faults := rules.Validate(d.Instance())
+	if faults == nil {
+		return nil, nil
+	}
+	return faults.Warnings(), faults.Errors()
 }
 
 // IsEmpty returns true if no payload or raw JSON has been set yet.
@@ -110,17 +129,35 @@ func (d *Object) Embedded() any {
 // document payload. If the object implements the Identifiable
 // interface, it will also ensure the UUID is set.
 func (d *Object) Calculate() error {
+	d.prepareUUID()
+	pl, ok := d.payload.(Calculable)
+	if !ok {
+		return nil
+	}
+	return pl.Calculate()
+}
+
+// CalculateWithRecorder behaves like Calculate, but also records in the
+// recorder the changes made to the document's inputs, such as normalizations
+// and default values. An error is returned if the document does not implement
+// the Recordable interface, which is currently only the case for the bill
+// documents.
+func (d *Object) CalculateWithRecorder(rec *norm.Recorder) error {
+	pl, ok := d.payload.(Recordable)
+	if !ok {
+		return errors.New("document cannot record changes")
+	}
+	d.prepareUUID()
+	return pl.CalculateWithRecorder(rec)
+}
+
+func (d *Object) prepareUUID() {
 	if ident, ok := d.payload.(Identifiable); ok {
 		id := ident.GetUUID()
 		if id.IsZero() {
 			ident.SetUUID(uuid.V7())
 		}
 	}
-	pl, ok := d.payload.(Calculable)
-	if !ok {
-		return nil
-	}
-	return pl.Calculate()
 }
 
 // Correct will attempt to run the correction method on the document
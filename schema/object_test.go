@@ -9,6 +9,8 @@ import (
 	"github.com/invopop/gobl/note"
 	"github.com/invopop/gobl/num"
 	"github.com/invopop/gobl/org"
+	"github.com/invopop/gobl/rules"
+	"github.com/invopop/gobl/rules/is"
 	"github.com/invopop/gobl/schema"
 	"github.com/invopop/gobl/tax"
 	"github.com/invopop/gobl/uuid"
@@ -20,6 +22,21 @@ import (
 
 // See also document tests performed in `gobl` package.
 
+func init() {
+	// Warnings are only raised for messages titled "warn".
+	rules.Register("schema_test", rules.GOBL.Add("SCHEMATEST"),
+		rules.For(new(note.Message),
+			rules.When(is.Expr(`Title == "warn"`),
+				rules.Warn(
+					rules.Field("meta",
+						rules.Assert("01", "meta data is recommended", is.Present),
+					),
+				),
+			),
+		),
+	)
+}
+
 func TestObjectUUID(t *testing.T) {
 	tr := &tax.RegimeDef{} // doesn't have a UUID field!
 	obj, err := schema.NewObject(tr)
@@ -119,6 +136,28 @@ func TestObjectValidate(t *testing.T) {
 			assert.NoError(t, obj.Validate())
 		})
 	})
+
+	t.Run("with warnings", func(t *testing.T) {
+		msg := &note.Message{
+			Title:   "warn",
+			Content: "hello",
+		}
+		obj, err := schema.NewObject(msg)
+		require.NoError(t, err)
+		require.NoError(t, obj.Calculate())
+		assert.Nil(t, obj.Validate(), "warnings do not make the object invalid")
+
+		warnings, errs := obj.ValidateWithWarnings()
+		assert.Nil(t, errs)
+		require.NotNil(t, warnings)
+		assert.True(t, warnings.HasCode("GOBL-SCHEMATEST-NOTE-MESSAGE-01"))
+
+		msg.Content = ""
+		warnings, errs = obj.ValidateWithWarnings()
+		assert.True(t, warnings.HasCode("GOBL-SCHEMATEST-NOTE-MESSAGE-01"))
+		assert.True(t, errs.HasCode("GOBL-NOTE-MESSAGE-01"))
+		assert.False(t, obj.Validate().HasCode("GOBL-SCHEMATEST-NOTE-MESSAGE-01"))
+	})
 }
 
 func TestObjectIsEmpty(t *testing.T) {
@@ -316,6 +355,143 @@ func TestObjectHasPayload(t *testing.T) {
 }
 
 // exampleInvoice defines a simple invoice example pre-calculations.
+func TestObjectPatch(t *testing.T) {
+	newObject := func(t *testing.T) *schema.Object {
+		t.Helper()
+		obj, err := schema.NewObject(exampleInvoice())
+		require.NoError(t, err)
+		require.NoError(t, obj.Calculate())
+		return obj
+	}
+
+	t.Run("json patch", func(t *testing.T) {
+		obj := newObject(t)
+		id := obj.UUID()
+		err := obj.Patch([]byte(`[
+			{"op":"test","path":"/code","value":"000123"},
+			{"op":"replace","path":"/lines/0/quantity","value":"2"},
+			{"op":"add","path":"/notes","value":[{"text":"Patched"}]}
+		]`))
+		require.NoError(t, err)
+		inv := obj.Instance().(*bill.Invoice)
+		assert.Equal(t, id, inv.UUID)
+		assert.Equal(t, "86.40", inv.Totals.Sum.String(), "recalculated")
+		assert.Equal(t, "Patched", inv.Notes[0].Text)
+	})
+
+	t.Run("merge patch", func(t *testing.T) {
+		obj := newObject(t)
+		err := obj.Patch([]byte(`{"series":null,"customer":{"name":"New Customer"}}`))
+		require.NoError(t, err)
+		inv := obj.Instance().(*bill.Invoice)
+		assert.Empty(t, inv.Series)
+		assert.Equal(t, "New Customer", inv.Customer.Name)
+		assert.Equal(t, "54387763P", inv.Customer.TaxID.Code.String())
+	})
+
+	t.Run("calculated fields", func(t *testing.T) {
+		obj := newObject(t)
+		err := obj.Patch([]byte(`[{"op":"replace","path":"/totals/sum","value":"10.00"}]`))
+		assert.ErrorIs(t, err, schema.ErrPatch)
+		assert.ErrorContains(t, err, "patch: /totals/sum is calculated and cannot be modified")
+
+		err = obj.Patch([]byte(`{"lines":[{"quantity":"1","item":{"name":"New","price":"10.00"},"total":"1.00"}]}`))
+		require.NoError(t, err, "lines replaced as a whole")
+		inv := obj.Instance().(*bill.Invoice)
+		assert.Equal(t, 1, inv.Lines[0].Index)
+		assert.Equal(t, "10.00", inv.Lines[0].Total.String(), "recalculated")
+
+		err = obj.Patch([]byte(`[{"op":"add","path":"/lines/-","value":{"quantity":"1","item":{"name":"New","price":"10.00"},"sum":"999"}}]`))
+		require.NoError(t, err)
+		inv = obj.Instance().(*bill.Invoice)
+		assert.Equal(t, 2, inv.Lines[1].Index)
+		assert.Equal(t, "10.00", inv.Lines[1].Sum.String(), "recalculated")
+
+		err = obj.Patch([]byte(`[{"op":"copy","from":"/lines/0","path":"/lines/-"}]`))
+		require.NoError(t, err)
+		inv = obj.Instance().(*bill.Invoice)
+		assert.Equal(t, 3, inv.Lines[2].Index)
+		assert.Equal(t, "30.00", inv.Totals.Sum.String())
+
+		err = obj.Patch([]byte(`[{"op":"copy","from":"/lines/0/total","path":"/lines/1/sum"}]`))
+		assert.ErrorContains(t, err, "/lines/1/sum is calculated")
+
+		err = obj.Patch([]byte(`[{"op":"replace","path":"/lines/0/taxes/0/percent","value":"10%"}]`))
+		assert.ErrorContains(t, err, "/lines/0/taxes/0/percent is calculated")
+
+		err = obj.Patch([]byte(`{"totals":null}`))
+		assert.ErrorContains(t, err, "/totals is calculated")
+
+		err = obj.Patch([]byte(`[{"op":"move","from":"/lines/0/total","path":"/code"}]`))
+		assert.ErrorContains(t, err, "/lines/0/total is calculated")
+	})
+
+	t.Run("defaulted fields", func(t *testing.T) {
+		obj := newObject(t)
+		err := obj.Patch([]byte(`[{"op":"replace","path":"/issue_date","value":"2022-03-01"}]`))
+		require.NoError(t, err)
+		inv := obj.Instance().(*bill.Invoice)
+		assert.Equal(t, "2022-03-01", inv.IssueDate.String())
+
+		err = obj.Patch([]byte(`{"type":"credit-note"}`))
+		require.NoError(t, err)
+		inv = obj.Instance().(*bill.Invoice)
+		assert.Equal(t, bill.InvoiceTypeCreditNote, inv.Type)
+	})
+
+	t.Run("document", func(t *testing.T) {
+		obj := newObject(t)
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		err = obj.Patch([]byte(`[{"op":"replace","path":"","value":` + string(data) + `}]`))
+		assert.ErrorIs(t, err, schema.ErrPatch)
+		assert.ErrorContains(t, err, "patch: document cannot be replaced")
+
+		err = obj.Patch([]byte(`null`))
+		assert.ErrorContains(t, err, "patch: document cannot be replaced")
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		obj := newObject(t)
+		err := obj.Patch([]byte(`{"$schema":"https://gobl.org/draft-0/note/message"}`))
+		assert.ErrorContains(t, err, "patch: /$schema cannot be modified")
+	})
+
+	t.Run("failures leave object untouched", func(t *testing.T) {
+		obj := newObject(t)
+		inv := obj.Instance().(*bill.Invoice)
+
+		err := obj.Patch([]byte(`[{"op":"replace","path":"/code","value":"X"},{"op":"test","path":"/series","value":"FOO"}]`))
+		assert.ErrorContains(t, err, "patch: jsonpatch: operation 1 (test /series): test failed")
+		assert.Equal(t, inv, obj.Instance())
+
+		err = obj.Patch([]byte(`{"supplier":null}`))
+		faults, ok := err.(rules.Faults)
+		require.True(t, ok)
+		assert.NotEmpty(t, faults)
+		assert.Equal(t, inv, obj.Instance())
+		assert.Equal(t, "000123", inv.Code.String())
+	})
+
+	t.Run("with warnings", func(t *testing.T) {
+		obj, err := schema.NewObject(&note.Message{Content: "hello"})
+		require.NoError(t, err)
+		require.NoError(t, obj.Calculate())
+		require.NoError(t, obj.Patch([]byte(`{"title":"warn"}`)))
+		msg := obj.Instance().(*note.Message)
+		assert.Equal(t, "warn", msg.Title)
+		warnings, _ := obj.ValidateWithWarnings()
+		assert.True(t, warnings.HasCode("GOBL-SCHEMATEST-NOTE-MESSAGE-01"))
+	})
+
+	t.Run("passthrough", func(t *testing.T) {
+		obj := new(schema.Object)
+		require.NoError(t, json.Unmarshal([]byte(`{"$schema":"https://example.com/unknown","foo":"bar"}`), obj))
+		err := obj.Patch([]byte(`{"foo":"baz"}`))
+		assert.ErrorContains(t, err, "patch: object has no payload")
+	})
+}
+
 func exampleInvoice() *bill.Invoice {
 	return &bill.Invoice{
 		Series: "TEST",
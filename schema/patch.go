@@ -0,0 +1,154 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/invopop/gobl/pkg/jsonpatch"
+)
+
+const (
+	// ErrPatch is returned when a patch is malformed, cannot be applied, or
+	// attempts to modify fields that may not be patched.
+	ErrPatch Error = "patch"
+)
+
+// Patch applies a JSON Patch (RFC 6902) or, when the data is not an array of
+// operations, a JSON Merge Patch (RFC 7396) to the object's JSON
+// representation. Patches that replace the whole document, modify the
+// `$schema`, or target a field marked as calculated in the JSON Schema, such
+// as totals, line sums, or tax percents, are rejected. Calculated fields that
+// are also marked as defaulted, like an invoice's type or issue date, are
+// only set when empty so may be patched.
+//
+// The patched document is calculated and validated before replacing the
+// current payload, so the object is left untouched if any step fails.
+// Calculated members inside the object or array values written by the patch
+// are overwritten during calculation.
+// Warnings do not prevent the patch from being applied. Any pointers to the
+// previous payload will no longer refer to the object's contents after a
+// successful patch.
+func (d *Object) Patch(data []byte) error {
+	if d.payload == nil {
+		return fmt.Errorf("%w: object has no payload", ErrPatch)
+	}
+	paths, err := jsonpatch.Paths(data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPatch, err)
+	}
+	for _, p := range paths {
+		toks, err := jsonpatch.Tokens(p)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrPatch, err)
+		}
+		if len(toks) == 0 {
+			return fmt.Errorf("%w: document cannot be replaced", ErrPatch)
+		}
+		if toks[0] == "$schema" {
+			return fmt.Errorf("%w: %s cannot be modified", ErrPatch, p)
+		}
+		if calculatedField(reflect.TypeOf(d.payload), toks) {
+			return fmt.Errorf("%w: %s is calculated and cannot be modified", ErrPatch, p)
+		}
+	}
+
+	doc, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	doc, err = jsonpatch.Apply(doc, data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPatch, err)
+	}
+	nd := new(Object)
+	if err := json.Unmarshal(doc, nd); err != nil {
+		return fmt.Errorf("%w: %w", ErrPatch, err)
+	}
+	if nd.Schema != d.Schema {
+		return fmt.Errorf("%w: schema cannot be modified", ErrPatch)
+	}
+	if err := nd.Calculate(); err != nil {
+		return err
+	}
+	// Validate only reports errors, so warnings never block a patch.
+	if faults := nd.Validate(); faults != nil {
+		return faults
+	}
+	*d = *nd
+	return nil
+}
+
+// calculatedField returns true if any of the struct fields referenced by the
+// JSON Pointer tokens, starting from the provided type, is calculated.
+func calculatedField(t reflect.Type, toks []string) bool {
+	for _, tok := range toks {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			sf, ok := jsonField(t, tok)
+			if !ok {
+				return false
+			}
+			if isCalculated(sf) {
+				return true
+			}
+			t = sf.Type
+		case reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// jsonField finds the struct field with the given JSON name, including
+// fields of embedded structs whose properties are flattened into the parent.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if tag == "" && sf.Anonymous {
+			et := sf.Type
+			if et.Kind() == reflect.Pointer {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct {
+				if f, ok := jsonField(et, name); ok {
+					return f, true
+				}
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if tag == name || (tag == "" && sf.Name == name) {
+			return sf, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// isCalculated returns true if the field is marked as calculated, unless it
+// is also marked as defaulted, as these are user inputs that are only
+// calculated when empty.
+func isCalculated(sf reflect.StructField) bool {
+	calculated := false
+	for _, kv := range strings.Split(sf.Tag.Get("jsonschema_extras"), ",") {
+		switch kv {
+		case "calculated=true":
+			calculated = true
+		case "defaulted=true":
+			return false
+		}
+	}
+	return calculated
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/invopop/gobl/i18n"
 	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/rules/is"
 )
@@ -38,12 +39,18 @@ func init() {
 	Register(GOBL.Add("schema"),
 		Object{},
 	)
+	Register(GOBL.Add("i18n"), i18n.String{})
 	rules.Register(
 		"schema",
 		rules.GOBL.Add("SCHEMA"),
 		idRules(),
 		objectRules(),
 	)
+	rules.Register(
+		"i18n",
+		rules.GOBL,
+		langRules(),
+	)
 }
 
 // ID contains the official schema URL.
@@ -191,6 +191,22 @@ func comboRules() *rules.Set {
 	)
 }
 
+// ComboNotRetained provides a rules test that checks that the tax combo's
+// category is not retained in the regime, either from the combo's country or
+// the validation context.
+func ComboNotRetained() rules.Test {
+	return is.FuncContext("not retained", comboNotRetained)
+}
+
+func comboNotRetained(ctx rules.Context, val any) bool {
+	combo, ok := val.(*Combo)
+	if !ok || combo == nil {
+		return true
+	}
+	cd := regimeDefForCombo(ctx, combo).CategoryDef(combo.Category)
+	return cd == nil || !cd.Retained
+}
+
 // regimeDefFromContext returns the RegimeDef from the validation context.
 func regimeDefFromContext(ctx rules.Context) *RegimeDef {
 	if r, ok := ctx.Value(regimeContextKey).(Regime); ok {
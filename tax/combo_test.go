@@ -7,6 +7,7 @@ import (
 	"github.com/invopop/gobl/cbc"
 	"github.com/invopop/gobl/norm"
 	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/tax"
 	"github.com/invopop/jsonschema"
 	"github.com/stretchr/testify/assert"
@@ -125,6 +126,19 @@ func TestComboNormalize(t *testing.T) {
 
 }
 
+func TestComboNotRetained(t *testing.T) {
+	test, ok := tax.ComboNotRetained().(interface {
+		CheckWithContext(rc *rules.Context, val any) bool
+	})
+	require.True(t, ok)
+	rc := new(rules.Context)
+	tax.RegimeContext("ES")(rc)
+	assert.True(t, test.CheckWithContext(rc, &tax.Combo{Category: "VAT"}))
+	assert.False(t, test.CheckWithContext(rc, &tax.Combo{Category: "IRPF"}))
+	assert.True(t, test.CheckWithContext(rc, &tax.Combo{Category: "IRPF", Country: "PT"}), "combo country overrides regime")
+	assert.True(t, test.CheckWithContext(new(rules.Context), &tax.Combo{Category: "IRPF"}), "unknown without regime")
+}
+
 func TestComboUnmarshal(t *testing.T) {
 	t.Run("with tags", func(t *testing.T) {
 		data := []byte(`{"cat":"VAT","tags":["standard"],"percent":"20%"}`)
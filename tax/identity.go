@@ -71,7 +71,7 @@ func ParseIdentity(tin string) (*Identity, error) {
 		Code:    cbc.Code(tin[2:]),
 	}
 	id.Normalize()
-	if err := rules.Validate(id); err != nil {
+	if err := rules.ValidateErrors(id); err != nil {
 		return nil, err
 	}
 	return id, nil
@@ -119,8 +119,10 @@ func (id *Identity) Normalize() {
 
 func identityRules() *rules.Set {
 	return rules.For(new(Identity),
-		rules.Field("country",
-			rules.Assert("01", "tax id country code is always required", is.Present),
+		rules.Autofix(fixIdentityCountry,
+			rules.Field("country",
+				rules.Assert("01", "tax id country code is always required", is.Present),
+			),
 		),
 		rules.Field("code",
 			rules.Assert("02", "tax id code must have a valid format", is.Matches(IdentityCodePattern)),
@@ -131,6 +133,35 @@ func identityRules() *rules.Set {
 	)
 }
 
+// fixIdentityCountry derives a missing country from the first two letters of
+// the code, as provided by ParseIdentity, but only when they belong to a tax
+// regime whose rules accept the rest of the code.
+func fixIdentityCountry(ptr any) bool {
+	id, ok := ptr.(*Identity)
+	if !ok || id.Country != "" {
+		return false
+	}
+	code := IdentityCodeBadCharsRegexp.ReplaceAllString(strings.ToUpper(id.Code.String()), "")
+	if len(code) <= 2 {
+		return false
+	}
+	nid := &Identity{
+		Country: l10n.TaxCountryCode(code[:2]),
+		Code:    cbc.Code(code[2:]),
+		Scheme:  id.Scheme,
+		Type:    id.Type,
+	}
+	if nid.Regime() == nil {
+		return false
+	}
+	nid.Normalize()
+	if rules.ValidateErrors(nid) != nil {
+		return false
+	}
+	*id = *nid
+	return true
+}
+
 // InEU checks if the tax identity is from a country that is part of the EU on
 // the given date.
 func (id *Identity) InEU(date cal.Date) bool {
@@ -93,6 +93,32 @@ func TestParseIdentity(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid tax identity code")
 }
 
+func TestIdentityFix(t *testing.T) {
+	t.Run("country from code", func(t *testing.T) {
+		tID := &tax.Identity{Code: "es-x3157928m"}
+		fixed, faults := rules.Fix(tID)
+		assert.NoError(t, faults)
+		assert.True(t, fixed.HasCode("GOBL-TAX-IDENTITY-01"))
+		assert.Equal(t, "ES", tID.Country.String())
+		assert.Equal(t, "X3157928M", tID.Code.String())
+	})
+
+	t.Run("invalid code for country", func(t *testing.T) {
+		tID := &tax.Identity{Code: "ES1234"}
+		fixed, faults := rules.Fix(tID)
+		assert.NoError(t, fixed)
+		assert.True(t, faults.HasCode("GOBL-TAX-IDENTITY-01"))
+		assert.Empty(t, tID.Country)
+	})
+
+	t.Run("unknown country", func(t *testing.T) {
+		tID := &tax.Identity{Code: "B12345678"}
+		fixed, faults := rules.Fix(tID)
+		assert.NoError(t, fixed)
+		assert.True(t, faults.HasCode("GOBL-TAX-IDENTITY-01"))
+	})
+}
+
 func TestIdentityGetScheme(t *testing.T) {
 	t.Run("use override", func(t *testing.T) {
 		tID := &tax.Identity{
@@ -32,8 +32,25 @@ func (n *Note) SameAs(n2 *Note) bool {
 
 func noteRules() *rules.Set {
 	return rules.For(new(Note),
-		rules.Field("text",
-			rules.Assert("01", "tax note text is required", is.Present),
+		rules.Autofix(fixNoteText,
+			rules.Field("text",
+				rules.Assert("01", "tax note text is required", is.Present),
+			),
 		),
 	)
 }
+
+// fixNoteText fills in missing text with the name of the note's key in one
+// of the global tax categories, such as "Reverse charge".
+func fixNoteText(ptr any) bool {
+	n, ok := ptr.(*Note)
+	if !ok || n.Text != "" {
+		return false
+	}
+	kd := Category(n.Category).KeyDef(n.Key)
+	if kd == nil || kd.Name.IsEmpty() {
+		return false
+	}
+	n.Text = kd.Name.String()
+	return true
+}
@@ -67,6 +67,30 @@ func TestNoteValidation(t *testing.T) {
 	})
 }
 
+func TestNoteFix(t *testing.T) {
+	t.Run("text from key", func(t *testing.T) {
+		n := &tax.Note{
+			Category: "VAT",
+			Key:      "reverse-charge",
+		}
+		fixed, faults := rules.Fix(n)
+		assert.NoError(t, faults)
+		assert.True(t, fixed.HasCode("GOBL-TAX-NOTE-01"))
+		assert.Equal(t, "Reverse charge", n.Text)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		n := &tax.Note{
+			Category: "VAT",
+			Key:      "foo",
+		}
+		fixed, faults := rules.Fix(n)
+		assert.NoError(t, fixed)
+		assert.True(t, faults.HasCode("GOBL-TAX-NOTE-01"))
+		assert.Empty(t, n.Text)
+	})
+}
+
 func TestNoteNormalize(t *testing.T) {
 	t.Run("nil note", func(t *testing.T) {
 		var n *tax.Note
@@ -47,6 +47,9 @@ type RateValueDef struct {
 	Ext Extensions `json:"ext,omitzero" jsonschema:"title=Extensions"`
 	// Date from which this value should be applied.
 	Since *cal.Date `json:"since,omitempty" jsonschema:"title=Since"`
+	// Last date on which this value may be applied, typically used for temporary
+	// rates that will revert to a previous value once expired.
+	Until *cal.Date `json:"until,omitempty" jsonschema:"title=Until"`
 	// Percent rate that should be applied
 	Percent num.Percentage `json:"percent" jsonschema:"title=Percent"`
 	// An additional surcharge to apply.
@@ -71,6 +74,9 @@ func rateDefRules() *rules.Set {
 				rules.Field("percent",
 					rules.Assert("04", "rate value percent is required", is.Present),
 				),
+				rules.Assert("05", "rate value until date must not be before since date",
+					is.Func("until after since", rateValueUntilValid),
+				),
 			),
 		),
 	)
@@ -101,13 +107,61 @@ func (r *RateDef) Value(date cal.Date, ext Extensions) *RateValueDef {
 				continue
 			}
 		}
-		if rv.Since == nil || !rv.Since.IsValid() || !rv.Since.After(date.Date) {
+		if rv.AppliesOn(date) {
 			return rv
 		}
 	}
 	return nil
 }
 
+// ValuesOn provides the list of values that apply on the provided date,
+// including one entry for each distinct set of extensions.
+func (r *RateDef) ValuesOn(date cal.Date) []*RateValueDef {
+	list := make([]*RateValueDef, 0)
+	for _, rv := range r.Values {
+		if !rv.AppliesOn(date) {
+			continue
+		}
+		dup := false
+		for _, v := range list {
+			if v.Ext.Equals(rv.Ext) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			list = append(list, rv)
+		}
+	}
+	return list
+}
+
+// AppliesOn returns true if the value is effective on the provided date
+// according to the since and until dates.
+func (rv *RateValueDef) AppliesOn(date cal.Date) bool {
+	if rv == nil {
+		return false
+	}
+	if rv.Since != nil && rv.Since.IsValid() && rv.Since.After(date.Date) {
+		return false
+	}
+	if rv.Until != nil && rv.Until.IsValid() && rv.Until.Before(date.Date) {
+		return false
+	}
+	return true
+}
+
+func rateValueUntilValid(val any) bool {
+	rv, ok := val.(*RateValueDef)
+	if !ok || rv == nil {
+		return true
+	}
+	if rv.Since == nil || rv.Until == nil {
+		return true
+	}
+	return !rv.Until.Before(rv.Since.Date)
+}
+
 func checkRateValuesOrder(list any) error {
 	values, ok := list.([]*RateValueDef)
 	if !ok {
@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
 	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/rules"
 	"github.com/invopop/gobl/tax"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -93,3 +96,105 @@ func TestRateDefValue(t *testing.T) {
 		assert.Equal(t, "10%", rv.Percent.String())
 	})
 }
+
+func TestRateDefValueUntil(t *testing.T) {
+	rate := &tax.RateDef{
+		Values: []*tax.RateValueDef{
+			{
+				Since:   cal.NewDate(2020, 7, 1),
+				Until:   cal.NewDate(2020, 12, 31),
+				Percent: num.MakePercentage(16, 2),
+			},
+			{
+				Since:   cal.NewDate(2007, 1, 1),
+				Percent: num.MakePercentage(19, 2),
+			},
+		},
+	}
+	t.Run("before temporary rate", func(t *testing.T) {
+		rv := rate.Value(cal.MakeDate(2020, 6, 30), tax.Extensions{})
+		require.NotNil(t, rv)
+		assert.Equal(t, "19%", rv.Percent.String())
+	})
+	t.Run("on until date", func(t *testing.T) {
+		rv := rate.Value(cal.MakeDate(2020, 12, 31), tax.Extensions{})
+		require.NotNil(t, rv)
+		assert.Equal(t, "16%", rv.Percent.String())
+	})
+	t.Run("after until date", func(t *testing.T) {
+		rv := rate.Value(cal.MakeDate(2021, 1, 1), tax.Extensions{})
+		require.NotNil(t, rv)
+		assert.Equal(t, "19%", rv.Percent.String())
+	})
+}
+
+func TestRateDefValuesOn(t *testing.T) {
+	rate := &tax.RateDef{
+		Values: []*tax.RateValueDef{
+			{
+				Ext:     tax.ExtensionsOf(cbc.CodeMap{"test-zone": "A"}),
+				Percent: num.MakePercentage(5, 2),
+			},
+			{
+				Since:   cal.NewDate(2021, 1, 1),
+				Percent: num.MakePercentage(19, 2),
+			},
+			{
+				Since:   cal.NewDate(2007, 1, 1),
+				Percent: num.MakePercentage(16, 2),
+			},
+		},
+	}
+	vs := rate.ValuesOn(cal.MakeDate(2022, 1, 1))
+	require.Len(t, vs, 2)
+	assert.Equal(t, "5%", vs[0].Percent.String())
+	assert.Equal(t, "19%", vs[1].Percent.String())
+
+	vs = rate.ValuesOn(cal.MakeDate(2000, 1, 1))
+	require.Len(t, vs, 1)
+	assert.Equal(t, "5%", vs[0].Percent.String())
+}
+
+func TestRateValueDefAppliesOn(t *testing.T) {
+	var rv *tax.RateValueDef
+	assert.False(t, rv.AppliesOn(cal.MakeDate(2020, 1, 1)))
+	rv = &tax.RateValueDef{
+		Since: cal.NewDate(2020, 1, 1),
+		Until: cal.NewDate(2020, 12, 31),
+	}
+	assert.False(t, rv.AppliesOn(cal.MakeDate(2019, 12, 31)))
+	assert.True(t, rv.AppliesOn(cal.MakeDate(2020, 1, 1)))
+	assert.True(t, rv.AppliesOn(cal.MakeDate(2020, 12, 31)))
+	assert.False(t, rv.AppliesOn(cal.MakeDate(2021, 1, 1)))
+}
+
+func TestRateDefValidation(t *testing.T) {
+	t.Run("valid until", func(t *testing.T) {
+		rate := &tax.RateDef{
+			Rate: tax.RateGeneral,
+			Name: i18n.NewString("General"),
+			Values: []*tax.RateValueDef{
+				{
+					Since:   cal.NewDate(2020, 7, 1),
+					Until:   cal.NewDate(2020, 12, 31),
+					Percent: num.MakePercentage(16, 2),
+				},
+			},
+		}
+		assert.NoError(t, rules.Validate(rate))
+	})
+	t.Run("until before since", func(t *testing.T) {
+		rate := &tax.RateDef{
+			Rate: tax.RateGeneral,
+			Name: i18n.NewString("General"),
+			Values: []*tax.RateValueDef{
+				{
+					Since:   cal.NewDate(2020, 7, 1),
+					Until:   cal.NewDate(2020, 6, 30),
+					Percent: num.MakePercentage(16, 2),
+				},
+			},
+		}
+		assert.ErrorContains(t, rules.Validate(rate), "[GOBL-TAX-RATEDEF-05] ($.values[0]) rate value until date must not be before since date")
+	})
+}
@@ -0,0 +1,117 @@
+package tax
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/i18n"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+)
+
+// RateQuery defines the filters that may be used to find the rates
+// that were effective on a given date across the registered regimes.
+type RateQuery struct {
+	// Date on which the rates should be effective, required.
+	Date cal.Date
+	// Country to limit results to, optional.
+	Country l10n.TaxCountryCode
+	// Category code to limit results to, optional.
+	Category cbc.Code
+}
+
+// EffectiveRate describes a rate value from a regime's category that
+// applies on a specific date, flattened so that it may be used directly
+// to build rate tables.
+type EffectiveRate struct {
+	// Country of the regime the rate belongs to.
+	Country l10n.TaxCountryCode `json:"country"`
+	// Category code of the rate.
+	Category cbc.Code `json:"category"`
+	// Rate key from the category.
+	Rate cbc.Key `json:"rate"`
+	// Keys the rate may be used with.
+	Keys []cbc.Key `json:"keys,omitempty"`
+	// Name of the rate.
+	Name i18n.String `json:"name"`
+	// Extensions that must be present for the value to apply.
+	Ext Extensions `json:"ext,omitzero"`
+	// Since when the value has been effective.
+	Since *cal.Date `json:"since,omitempty"`
+	// Until when the value will remain effective.
+	Until *cal.Date `json:"until,omitempty"`
+	// Percent rate that applies.
+	Percent num.Percentage `json:"percent"`
+	// Surcharge, if any, that also applies.
+	Surcharge *num.Percentage `json:"surcharge,omitempty"`
+}
+
+// QueryRates provides the list of rates that were effective on the query's
+// date across all the registered regimes, ordered by country, category
+// and rate key. Values for the same rate, such as those that depend on
+// extensions, keep their definition order.
+func QueryRates(q RateQuery) []*EffectiveRate {
+	list := make([]*EffectiveRate, 0)
+	for _, r := range AllRegimeDefs() {
+		if q.Country != "" && r.Country != q.Country {
+			continue
+		}
+		for _, cd := range r.Categories {
+			if q.Category != cbc.CodeEmpty && cd.Code != q.Category {
+				continue
+			}
+			list = append(list, cd.effectiveRates(r.Country, q.Date)...)
+		}
+	}
+	slices.SortStableFunc(list, func(a, b *EffectiveRate) int {
+		return cmp.Or(
+			cmp.Compare(a.Country, b.Country),
+			cmp.Compare(a.Category, b.Category),
+			cmp.Compare(a.Rate, b.Rate),
+		)
+	})
+	return list
+}
+
+// RatesOn provides the list of effective rates for each of the regime's
+// categories on the given date.
+func (r *RegimeDef) RatesOn(date cal.Date) []*EffectiveRate {
+	if r == nil {
+		return nil
+	}
+	list := make([]*EffectiveRate, 0)
+	for _, cd := range r.Categories {
+		list = append(list, cd.effectiveRates(r.Country, date)...)
+	}
+	return list
+}
+
+func (c *CategoryDef) effectiveRates(country l10n.TaxCountryCode, date cal.Date) []*EffectiveRate {
+	list := make([]*EffectiveRate, 0)
+	for _, rd := range c.Rates {
+		for _, rv := range rd.ValuesOn(date) {
+			if rv.Disabled {
+				continue
+			}
+			er := &EffectiveRate{
+				Country:  country,
+				Category: c.Code,
+				Rate:     rd.Rate,
+				Keys:     rd.Keys,
+				Name:     rd.Name,
+				Ext:      rv.Ext,
+				Since:    rv.Since,
+				Until:    rv.Until,
+				Percent:  rv.Percent,
+			}
+			if rv.Surcharge != nil {
+				s := *rv.Surcharge // copy
+				er.Surcharge = &s
+			}
+			list = append(list, er)
+		}
+	}
+	return list
+}
@@ -0,0 +1,90 @@
+package tax_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/invopop/gobl/cal"
+	_ "github.com/invopop/gobl/regimes"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryRates(t *testing.T) {
+	t.Run("germany during covid", func(t *testing.T) {
+		rates := tax.QueryRates(tax.RateQuery{
+			Date:     cal.MakeDate(2020, 7, 1),
+			Country:  "DE",
+			Category: tax.CategoryVAT,
+		})
+		require.Len(t, rates, 2)
+		assert.Equal(t, tax.RateGeneral, rates[0].Rate)
+		assert.Equal(t, "16%", rates[0].Percent.String())
+		assert.Equal(t, "2020-12-31", rates[0].Until.String())
+		assert.Equal(t, tax.RateReduced, rates[1].Rate)
+		assert.Equal(t, "5%", rates[1].Percent.String())
+	})
+	t.Run("germany after covid", func(t *testing.T) {
+		rates := tax.QueryRates(tax.RateQuery{
+			Date:     cal.MakeDate(2021, 7, 1),
+			Country:  "DE",
+			Category: tax.CategoryVAT,
+		})
+		require.Len(t, rates, 2)
+		assert.Equal(t, "19%", rates[0].Percent.String())
+		assert.Nil(t, rates[0].Until)
+		assert.Equal(t, "7%", rates[1].Percent.String())
+	})
+	t.Run("all regimes", func(t *testing.T) {
+		rates := tax.QueryRates(tax.RateQuery{
+			Date: cal.MakeDate(2024, 1, 1),
+		})
+		assert.NotEmpty(t, rates)
+		countries := make(map[string]bool)
+		for _, r := range rates {
+			countries[r.Country.String()] = true
+		}
+		assert.True(t, countries["DE"])
+		assert.True(t, countries["ES"])
+	})
+	t.Run("ordering", func(t *testing.T) {
+		rates := tax.QueryRates(tax.RateQuery{
+			Date: cal.MakeDate(2024, 1, 1),
+		})
+		require.NotEmpty(t, rates)
+		assert.True(t, slices.IsSortedFunc(rates, func(a, b *tax.EffectiveRate) int {
+			return cmp.Or(
+				cmp.Compare(a.Country, b.Country),
+				cmp.Compare(a.Category, b.Category),
+				cmp.Compare(a.Rate, b.Rate),
+			)
+		}))
+		assert.Equal(t, "AE", rates[0].Country.String())
+	})
+	t.Run("before any rates", func(t *testing.T) {
+		rates := tax.QueryRates(tax.RateQuery{
+			Date:    cal.MakeDate(1900, 1, 1),
+			Country: "DE",
+		})
+		assert.Empty(t, rates)
+	})
+}
+
+func TestRegimeDefRatesOn(t *testing.T) {
+	var r *tax.RegimeDef
+	assert.Nil(t, r.RatesOn(cal.MakeDate(2020, 1, 1)))
+
+	r = tax.RegimeDefFor("ES")
+	rates := r.RatesOn(cal.MakeDate(2011, 1, 1))
+	require.NotEmpty(t, rates)
+	assert.Equal(t, tax.CategoryVAT, rates[0].Category)
+	assert.Equal(t, "18.0%", rates[0].Percent.String())
+	for _, er := range rates {
+		if er.Surcharge != nil {
+			return
+		}
+	}
+	t.Error("expected equivalence surcharge rates")
+}
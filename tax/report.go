@@ -0,0 +1,306 @@
+package tax
+
+import (
+	"sort"
+
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+)
+
+// Report intervals used to group documents into periods according
+// to their tax date.
+const (
+	ReportIntervalMonth   cbc.Key = "month"
+	ReportIntervalQuarter cbc.Key = "quarter"
+	ReportIntervalYear    cbc.Key = "year"
+)
+
+// Reportable defines the methods a calculated document must provide in
+// order to be included in a tax report.
+type Reportable interface {
+	GetRegime() l10n.TaxCountryCode
+	GetCurrency() currency.Code
+	GetExchangeRates() []*currency.ExchangeRate
+	// GetTaxDate provides the date on which the document's taxes
+	// become accountable.
+	GetTaxDate() cal.Date
+	// GetTaxTotal provides the document's tax totals with amounts negated
+	// if the document reduces the tax liability, like credit notes, or
+	// nil if the document should not be reported.
+	GetTaxTotal() *Total
+}
+
+// Report aggregates the tax totals of multiple documents into periods,
+// typically used to prepare the figures required for tax returns.
+type Report struct {
+	// Currency in which all amounts are reported.
+	Currency currency.Code `json:"currency"`
+	// Interval used to group documents into periods.
+	Interval cbc.Key `json:"interval"`
+	// Periods containing the aggregated totals, in chronological order.
+	Periods []*ReportPeriod `json:"periods"`
+}
+
+// ReportPeriod contains the aggregated totals of the documents whose tax date
+// falls inside the period.
+type ReportPeriod struct {
+	// Period covered by the totals.
+	Period cal.Period `json:"period"`
+	// Number of documents included in the period.
+	Documents int `json:"documents"`
+	// Rows with the totals grouped by regime, category, key, extensions and percent.
+	Rows []*ReportRow `json:"rows"`
+	// Sum of all non-retained tax amounts, including surcharges.
+	Sum num.Amount `json:"sum"`
+	// Sum of all retained tax amounts, including surcharges.
+	Retained *num.Amount `json:"retained,omitempty"`
+}
+
+// ReportRow contains the totals for a specific combination of regime,
+// category, key, extensions and percent.
+type ReportRow struct {
+	// Country of the regime the taxes were applied in.
+	Country l10n.TaxCountryCode `json:"country"`
+	// Category code of the tax.
+	Category cbc.Code `json:"category"`
+	// Retained is true when the amounts were withheld by the customer.
+	Retained bool `json:"retained,omitempty"`
+	// Informative is true when the amounts do not affect totals.
+	Informative bool `json:"informative,omitempty"`
+	// Tax key if supported by the category.
+	Key cbc.Key `json:"key,omitempty"`
+	// Extensions used in the rate totals.
+	Ext Extensions `json:"ext,omitzero"`
+	// Percent applied, nil when exempt.
+	Percent *num.Percentage `json:"percent,omitempty"`
+	// Sum of the taxable bases.
+	Base num.Amount `json:"base"`
+	// Sum of the tax amounts, excluding surcharges.
+	Amount num.Amount `json:"amount"`
+	// Sum of the surcharge amounts, if any.
+	Surcharge *num.Amount `json:"surcharge,omitempty"`
+}
+
+// ReportIntervals contains the list of supported report intervals.
+var ReportIntervals = []cbc.Key{
+	ReportIntervalMonth,
+	ReportIntervalQuarter,
+	ReportIntervalYear,
+}
+
+// NewReport prepares a new empty report for the currency and interval. Months
+// will be used if the interval is empty. An error is returned if the currency
+// is not known or the interval is not supported.
+func NewReport(cur currency.Code, interval cbc.Key) (*Report, error) {
+	if cur.Def() == nil {
+		return nil, ErrInvalid.WithMessage("unknown report currency '%s'", cur)
+	}
+	if interval == cbc.KeyEmpty {
+		interval = ReportIntervalMonth
+	}
+	if !interval.In(ReportIntervals...) {
+		return nil, ErrInvalid.WithMessage("unsupported report interval '%s'", interval)
+	}
+	r := &Report{
+		Currency: cur,
+		Interval: interval,
+		Periods:  make([]*ReportPeriod, 0),
+	}
+	return r, nil
+}
+
+// reportEntry contains the details of a document checked before being
+// added to the report.
+type reportEntry struct {
+	regime l10n.TaxCountryCode
+	total  *Total
+	rate   *currency.ExchangeRate
+	start  cal.Date
+	end    cal.Date
+}
+
+// Add includes the tax totals of the provided documents in the report.
+// Documents in a different currency will be converted using their own
+// exchange rates, or an error will be raised if no rate is available.
+// All the documents are checked before any are added, so the report is
+// left untouched if an error is returned.
+func (r *Report) Add(docs ...Reportable) error {
+	def := r.Currency.Def()
+	if def == nil {
+		return ErrInvalid.WithMessage("unknown report currency '%s'", r.Currency)
+	}
+	entries := make([]*reportEntry, 0, len(docs))
+	for _, doc := range docs {
+		e, err := r.prepare(doc)
+		if err != nil {
+			return err
+		}
+		if e != nil {
+			entries = append(entries, e)
+		}
+	}
+	zero := def.Zero()
+	for _, e := range entries {
+		r.add(e, zero)
+	}
+	return nil
+}
+
+// prepare checks the document can be added to the report, or returns nil
+// if the document does not need to be reported.
+func (r *Report) prepare(doc Reportable) (*reportEntry, error) {
+	t := doc.GetTaxTotal()
+	if t == nil {
+		return nil, nil
+	}
+	e := &reportEntry{
+		regime: doc.GetRegime(),
+		total:  t,
+	}
+	if doc.GetCurrency() != r.Currency {
+		e.rate = currency.MatchExchangeRate(doc.GetExchangeRates(), doc.GetCurrency(), r.Currency)
+		if e.rate == nil {
+			return nil, ErrInvalid.WithMessage("no exchange rate from '%s' to '%s'", doc.GetCurrency(), r.Currency)
+		}
+	}
+	date := doc.GetTaxDate()
+	if date.IsZero() {
+		return nil, ErrInvalidDate.WithMessage("missing tax date")
+	}
+	var err error
+	if e.start, e.end, err = r.periodBounds(date); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *Report) add(e *reportEntry, zero num.Amount) {
+	period := r.periodFor(e.start, e.end)
+	conv := func(a num.Amount) num.Amount {
+		if e.rate != nil {
+			a = e.rate.Convert(a)
+		}
+		return a.Rescale(zero.Exp())
+	}
+	period.Documents++
+	for _, ct := range e.total.Categories {
+		for _, rt := range ct.Rates {
+			country := rt.Country
+			if country == "" {
+				country = e.regime
+			}
+			row := period.rowFor(country, ct, rt, zero)
+			row.Base = row.Base.Add(conv(rt.Base))
+			row.Amount = row.Amount.Add(conv(rt.Amount))
+			if rt.Surcharge != nil {
+				s := zero
+				if row.Surcharge != nil {
+					s = *row.Surcharge
+				}
+				s = s.Add(conv(rt.Surcharge.Amount))
+				row.Surcharge = &s
+			}
+		}
+	}
+	period.calculate(zero)
+}
+
+// periodBounds provides the start and end dates of the period that
+// includes the date according to the report's interval.
+func (r *Report) periodBounds(date cal.Date) (start, end cal.Date, err error) {
+	switch r.Interval {
+	case ReportIntervalMonth:
+		start = cal.MakeDate(date.Year, date.Month, 1)
+		end = start.Add(0, 1, -1)
+	case ReportIntervalQuarter:
+		start = cal.MakeDate(date.Year, date.Month-(date.Month-1)%3, 1)
+		end = start.Add(0, 3, -1)
+	case ReportIntervalYear:
+		start = cal.MakeDate(date.Year, 1, 1)
+		end = start.Add(1, 0, -1)
+	default:
+		err = ErrInvalid.WithMessage("unsupported report interval '%s'", r.Interval)
+	}
+	return start, end, err
+}
+
+func (r *Report) periodFor(start, end cal.Date) *ReportPeriod {
+	for _, p := range r.Periods {
+		if p.Period.Start == start {
+			return p
+		}
+	}
+	p := &ReportPeriod{
+		Period: cal.Period{Start: start, End: end},
+		Rows:   make([]*ReportRow, 0),
+	}
+	r.Periods = append(r.Periods, p)
+	sort.SliceStable(r.Periods, func(i, j int) bool {
+		return r.Periods[i].Period.Start.Before(r.Periods[j].Period.Start.Date)
+	})
+	return p
+}
+
+func (p *ReportPeriod) rowFor(country l10n.TaxCountryCode, ct *CategoryTotal, rt *RateTotal, zero num.Amount) *ReportRow {
+	for _, row := range p.Rows {
+		if row.matches(country, ct, rt) {
+			return row
+		}
+	}
+	row := &ReportRow{
+		Country:     country,
+		Category:    ct.Code,
+		Retained:    ct.Retained,
+		Informative: ct.Informative,
+		Key:         rt.Key,
+		Ext:         rt.Ext,
+		Base:        zero,
+		Amount:      zero,
+	}
+	if rt.Percent != nil {
+		pc := *rt.Percent
+		row.Percent = &pc
+	}
+	p.Rows = append(p.Rows, row)
+	return row
+}
+
+func (row *ReportRow) matches(country l10n.TaxCountryCode, ct *CategoryTotal, rt *RateTotal) bool {
+	if row.Country != country || row.Category != ct.Code || row.Key != rt.Key {
+		return false
+	}
+	if !row.Ext.Equals(rt.Ext) {
+		return false
+	}
+	if row.Percent == nil || rt.Percent == nil {
+		return row.Percent == nil && rt.Percent == nil
+	}
+	return row.Percent.Equals(*rt.Percent)
+}
+
+func (p *ReportPeriod) calculate(zero num.Amount) {
+	p.Sum = zero
+	p.Retained = nil
+	for _, row := range p.Rows {
+		if row.Informative {
+			continue
+		}
+		amount := row.Amount
+		if row.Surcharge != nil {
+			amount = amount.Add(*row.Surcharge)
+		}
+		if row.Retained {
+			r := zero
+			if p.Retained != nil {
+				r = *p.Retained
+			}
+			r = r.Add(amount)
+			p.Retained = &r
+		} else {
+			p.Sum = p.Sum.Add(amount)
+		}
+	}
+}
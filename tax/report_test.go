@@ -0,0 +1,185 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/invopop/gobl/cal"
+	"github.com/invopop/gobl/cbc"
+	"github.com/invopop/gobl/currency"
+	"github.com/invopop/gobl/l10n"
+	"github.com/invopop/gobl/num"
+	"github.com/invopop/gobl/tax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type reportDoc struct {
+	regime   l10n.TaxCountryCode
+	currency currency.Code
+	rates    []*currency.ExchangeRate
+	date     cal.Date
+	total    *tax.Total
+}
+
+func (d *reportDoc) GetRegime() l10n.TaxCountryCode             { return d.regime }
+func (d *reportDoc) GetCurrency() currency.Code                 { return d.currency }
+func (d *reportDoc) GetExchangeRates() []*currency.ExchangeRate { return d.rates }
+func (d *reportDoc) GetTaxDate() cal.Date                       { return d.date }
+func (d *reportDoc) GetTaxTotal() *tax.Total                    { return d.total }
+
+func reportTotal(base, amount int64, retained int64) *tax.Total {
+	t := &tax.Total{
+		Categories: []*tax.CategoryTotal{
+			{
+				Code: tax.CategoryVAT,
+				Rates: []*tax.RateTotal{
+					{
+						Key:     tax.KeyStandard,
+						Base:    num.MakeAmount(base, 2),
+						Percent: num.NewPercentage(210, 3),
+						Amount:  num.MakeAmount(amount, 2),
+					},
+				},
+				Amount: num.MakeAmount(amount, 2),
+			},
+		},
+		Sum: num.MakeAmount(amount, 2),
+	}
+	if retained != 0 {
+		t.Categories = append(t.Categories, &tax.CategoryTotal{
+			Code:     "IRPF",
+			Retained: true,
+			Rates: []*tax.RateTotal{
+				{
+					Base:    num.MakeAmount(base, 2),
+					Percent: num.NewPercentage(150, 3),
+					Amount:  num.MakeAmount(retained, 2),
+				},
+			},
+			Amount: num.MakeAmount(retained, 2),
+		})
+		r := num.MakeAmount(retained, 2)
+		t.Retained = &r
+	}
+	return t
+}
+
+func TestReport(t *testing.T) {
+	t.Run("monthly periods", func(t *testing.T) {
+		r, err := tax.NewReport(currency.EUR, "")
+		require.NoError(t, err)
+		assert.Equal(t, tax.ReportIntervalMonth, r.Interval)
+		err = r.Add(
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 2, 10), total: reportTotal(10000, 2100, 1500)},
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 10), total: reportTotal(10000, 2100, 0)},
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 31), total: reportTotal(5000, 1050, 0).Negate()},
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 31)}, // ignored
+		)
+		require.NoError(t, err)
+		require.Len(t, r.Periods, 2)
+
+		p := r.Periods[0]
+		assert.Equal(t, "2024-01-01", p.Period.Start.String())
+		assert.Equal(t, "2024-01-31", p.Period.End.String())
+		assert.Equal(t, 2, p.Documents)
+		require.Len(t, p.Rows, 1)
+		assert.Equal(t, "ES", p.Rows[0].Country.String())
+		assert.Equal(t, "50.00", p.Rows[0].Base.String())
+		assert.Equal(t, "10.50", p.Rows[0].Amount.String())
+		assert.Equal(t, "10.50", p.Sum.String())
+		assert.Nil(t, p.Retained)
+
+		p = r.Periods[1]
+		assert.Equal(t, "2024-02-01", p.Period.Start.String())
+		assert.Equal(t, "2024-02-29", p.Period.End.String())
+		require.Len(t, p.Rows, 2)
+		assert.True(t, p.Rows[1].Retained)
+		assert.Equal(t, "21.00", p.Sum.String())
+		assert.Equal(t, "15.00", p.Retained.String())
+	})
+	t.Run("quarters and years", func(t *testing.T) {
+		r, err := tax.NewReport(currency.EUR, tax.ReportIntervalQuarter)
+		require.NoError(t, err)
+		require.NoError(t, r.Add(
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 6, 30), total: reportTotal(10000, 2100, 0)},
+		))
+		assert.Equal(t, "2024-04-01", r.Periods[0].Period.Start.String())
+		assert.Equal(t, "2024-06-30", r.Periods[0].Period.End.String())
+
+		r, err = tax.NewReport(currency.EUR, tax.ReportIntervalYear)
+		require.NoError(t, err)
+		require.NoError(t, r.Add(
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 6, 30), total: reportTotal(10000, 2100, 0)},
+		))
+		assert.Equal(t, "2024-01-01", r.Periods[0].Period.Start.String())
+		assert.Equal(t, "2024-12-31", r.Periods[0].Period.End.String())
+	})
+	t.Run("groups by extensions and country", func(t *testing.T) {
+		t1 := reportTotal(10000, 2100, 0)
+		t1.Categories[0].Rates[0].Ext = tax.ExtensionsOf(cbc.CodeMap{"es-tbai-product": "goods"})
+		t2 := reportTotal(10000, 2100, 0)
+		t2.Categories[0].Rates[0].Country = "PT"
+		r, err := tax.NewReport(currency.EUR, tax.ReportIntervalMonth)
+		require.NoError(t, err)
+		require.NoError(t, r.Add(
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 1), total: reportTotal(10000, 2100, 0)},
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 1), total: t1},
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 1), total: t2},
+		))
+		require.Len(t, r.Periods[0].Rows, 3)
+		assert.Equal(t, "PT", r.Periods[0].Rows[2].Country.String())
+	})
+	t.Run("currency conversion", func(t *testing.T) {
+		r, err := tax.NewReport(currency.EUR, tax.ReportIntervalMonth)
+		require.NoError(t, err)
+		doc := &reportDoc{
+			regime:   "ES",
+			currency: currency.USD,
+			date:     cal.MakeDate(2024, 1, 1),
+			total:    reportTotal(10000, 2100, 0),
+		}
+		err = r.Add(doc)
+		assert.ErrorContains(t, err, "no exchange rate from 'USD' to 'EUR'")
+
+		doc.rates = []*currency.ExchangeRate{
+			{From: currency.USD, To: currency.EUR, Amount: num.MakeAmount(5, 1)},
+		}
+		require.NoError(t, r.Add(doc))
+		assert.Equal(t, "50.00", r.Periods[0].Rows[0].Base.String())
+		assert.Equal(t, "10.50", r.Periods[0].Sum.String())
+	})
+	t.Run("invalid interval", func(t *testing.T) {
+		_, err := tax.NewReport(currency.EUR, "week")
+		assert.ErrorIs(t, err, tax.ErrInvalid)
+		assert.ErrorContains(t, err, "unsupported report interval 'week'")
+
+		r := &tax.Report{Currency: currency.EUR, Interval: "week"}
+		err = r.Add(&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 1), total: reportTotal(10000, 2100, 0)})
+		assert.ErrorContains(t, err, "unsupported report interval 'week'")
+	})
+	t.Run("unknown currency", func(t *testing.T) {
+		_, err := tax.NewReport("ZZZ", "")
+		assert.ErrorIs(t, err, tax.ErrInvalid)
+		assert.ErrorContains(t, err, "unknown report currency 'ZZZ'")
+
+		r := &tax.Report{Currency: "ZZZ", Interval: tax.ReportIntervalMonth}
+		err = r.Add(&reportDoc{regime: "ES", currency: "ZZZ", date: cal.MakeDate(2024, 1, 1), total: reportTotal(10000, 2100, 0)})
+		assert.ErrorContains(t, err, "unknown report currency 'ZZZ'")
+	})
+	t.Run("missing date", func(t *testing.T) {
+		r, err := tax.NewReport(currency.EUR, "")
+		require.NoError(t, err)
+		err = r.Add(&reportDoc{regime: "ES", currency: currency.EUR, total: reportTotal(10000, 2100, 0)})
+		assert.ErrorIs(t, err, tax.ErrInvalidDate)
+	})
+	t.Run("failures leave report untouched", func(t *testing.T) {
+		r, err := tax.NewReport(currency.EUR, "")
+		require.NoError(t, err)
+		err = r.Add(
+			&reportDoc{regime: "ES", currency: currency.EUR, date: cal.MakeDate(2024, 1, 1), total: reportTotal(10000, 2100, 0)},
+			&reportDoc{regime: "ES", currency: currency.USD, date: cal.MakeDate(2024, 1, 1), total: reportTotal(10000, 2100, 0)},
+		)
+		assert.ErrorContains(t, err, "no exchange rate from 'USD' to 'EUR'")
+		assert.Empty(t, r.Periods)
+	})
+}
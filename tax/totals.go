@@ -164,12 +164,23 @@ func (t *Total) Negate() *Total {
 	nt := t.Clone()
 	for _, ct := range nt.Categories {
 		ct.Amount = ct.Amount.Negate()
+		if ct.Surcharge != nil {
+			s := ct.Surcharge.Negate()
+			ct.Surcharge = &s
+		}
 		for _, rt := range ct.Rates {
 			rt.Base = rt.Base.Negate()
 			rt.Amount = rt.Amount.Negate()
+			if rt.Surcharge != nil {
+				rt.Surcharge.Amount = rt.Surcharge.Amount.Negate()
+			}
 		}
 	}
 	nt.Sum = t.Sum.Negate()
+	if t.Retained != nil {
+		r := t.Retained.Negate()
+		nt.Retained = &r
+	}
 	return nt
 }
 
@@ -211,6 +222,7 @@ func (t *Total) Clone() *Total {
 		nt.Categories[i] = new(CategoryTotal)
 		nt.Categories[i].Code = ct.Code
 		nt.Categories[i].Retained = ct.Retained
+		nt.Categories[i].Informative = ct.Informative
 		nt.Categories[i].Amount = ct.Amount
 		nt.Categories[i].Surcharge = ct.Surcharge
 		nt.Categories[i].Rates = make([]*RateTotal, len(ct.Rates))
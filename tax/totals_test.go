@@ -94,6 +94,52 @@ func TestTotalNegate(t *testing.T) {
 	}
 	tt2 := tt.Negate()
 	assert.Equal(t, int64(-2100), tt2.Category("VAT").Rates[0].Amount.Value())
+
+	t.Run("with surcharges and retained", func(t *testing.T) {
+		tt := &tax.Total{
+			Categories: []*tax.CategoryTotal{
+				{
+					Code: tax.CategoryVAT,
+					Rates: []*tax.RateTotal{
+						{
+							Base:    num.MakeAmount(10000, 2),
+							Percent: num.NewPercentage(210, 3),
+							Amount:  num.MakeAmount(2100, 2),
+							Surcharge: &tax.RateTotalSurcharge{
+								Percent: num.MakePercentage(52, 3),
+								Amount:  num.MakeAmount(520, 2),
+							},
+						},
+					},
+					Amount:    num.MakeAmount(2100, 2),
+					Surcharge: num.NewAmount(520, 2),
+				},
+				{
+					Code:     "IRPF",
+					Retained: true,
+					Rates: []*tax.RateTotal{
+						{
+							Base:    num.MakeAmount(10000, 2),
+							Percent: num.NewPercentage(150, 3),
+							Amount:  num.MakeAmount(1500, 2),
+						},
+					},
+					Amount: num.MakeAmount(1500, 2),
+				},
+			},
+			Sum:      num.MakeAmount(2620, 2),
+			Retained: num.NewAmount(1500, 2),
+		}
+		tt2 := tt.Negate()
+		vat := tt2.Category(tax.CategoryVAT)
+		assert.Equal(t, "-5.20", vat.Surcharge.String())
+		assert.Equal(t, "-5.20", vat.Rates[0].Surcharge.Amount.String())
+		assert.Equal(t, "-15.00", tt2.Retained.String())
+		assert.Equal(t, "-26.20", tt2.Sum.String())
+		// originals untouched
+		assert.Equal(t, "5.20", tt.Category(tax.CategoryVAT).Surcharge.String())
+		assert.Equal(t, "15.00", tt.Retained.String())
+	})
 }
 
 func TestTotalCategory(t *testing.T) {